@@ -0,0 +1,71 @@
+package errors
+
+import "fmt"
+
+// AuditResourceKey is the key of the field holding the resource of an audit event, see ToAuditEvent.
+const AuditResourceKey = "resource"
+
+// AuditOutcome tells who caused the failure recorded by an audit event.
+type AuditOutcome string
+
+// Outcomes of audit events.
+const (
+	// AuditOutcomeUserFailure is the outcome of the requests failed because of the actor, e.g. invalid,
+	// unauthenticated, denied or conflicting with the state of the resource.
+	AuditOutcomeUserFailure AuditOutcome = "user_failure"
+	// AuditOutcomeSystemFailure is the outcome of the requests failed because of the system.
+	AuditOutcomeSystemFailure AuditOutcome = "system_failure"
+)
+
+// AuditEvent is the structured audit record of a failed request, see ToAuditEvent.
+type AuditEvent struct {
+	Outcome AuditOutcome `json:"outcome"`
+	Kind    Kind         `json:"kind,omitempty"`
+	// Reason is the reason of the error, see WithReason, or else its kind.
+	Reason string `json:"reason,omitempty"`
+	// Resource is the AuditResourceKey field of the chain.
+	Resource string `json:"resource,omitempty"`
+	Actor    string `json:"actor,omitempty"`
+	Message  string `json:"message"`
+	// Fields are the merged fields of the chain.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// ToAuditEvent returns the audit record of the request of actor failed with err, nil if err is nil.
+//
+// The errors caused by the actor, of kind KindInvalid, KindNotFound, KindConflict, KindUnauthenticated,
+// KindPermissionDenied, KindRateLimited, KindPrecondition and KindCanceled, are user failures,
+// the other errors are system failures, see KindOf.
+func ToAuditEvent(err error, actor string, opts ...Option) *AuditEvent {
+	if IsNil(err) {
+		return nil
+	}
+
+	kind := KindOf(err)
+
+	e := &AuditEvent{
+		Kind:    kind,
+		Reason:  string(kind),
+		Actor:   actor,
+		Message: err.Error(),
+		Fields:  Fields(err, opts...),
+	}
+
+	switch kind {
+	case KindInvalid, KindNotFound, KindConflict, KindUnauthenticated, KindPermissionDenied,
+		KindRateLimited, KindPrecondition, KindCanceled:
+		e.Outcome = AuditOutcomeUserFailure
+	default:
+		e.Outcome = AuditOutcomeSystemFailure
+	}
+
+	if _, reason, ok := ReasonOf(err); ok {
+		e.Reason = reason
+	}
+
+	if r, ok := e.Fields[AuditResourceKey]; ok {
+		e.Resource = fmt.Sprint(r)
+	}
+
+	return e
+}
@@ -0,0 +1,69 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestToAuditEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, errors.ToAuditEvent(nil, "alice"))
+	})
+
+	t.Run("user failure", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithReason(
+			errors.WithKind(
+				errors.Enrich(errors.New("forbidden"), errors.AuditResourceKey, "orders/5", "tenant", "acme"),
+				errors.KindPermissionDenied,
+			),
+			"orders.example.com", "NOT_OWNER",
+		)
+
+		require.Equal(t, &errors.AuditEvent{
+			Outcome:  errors.AuditOutcomeUserFailure,
+			Kind:     errors.KindPermissionDenied,
+			Reason:   "NOT_OWNER",
+			Resource: "orders/5",
+			Actor:    "alice",
+			Message:  "delete order: forbidden",
+			Fields:   map[string]interface{}{errors.AuditResourceKey: "orders/5", "tenant": "acme"},
+		}, errors.ToAuditEvent(errors.Wrap(err, "delete order"), "alice"))
+	})
+
+	t.Run("outcome", func(t *testing.T) {
+		t.Parallel()
+
+		for kind, outcome := range map[errors.Kind]errors.AuditOutcome{
+			errors.KindUnknown:          errors.AuditOutcomeSystemFailure,
+			errors.KindInvalid:          errors.AuditOutcomeUserFailure,
+			errors.KindNotFound:         errors.AuditOutcomeUserFailure,
+			errors.KindConflict:         errors.AuditOutcomeUserFailure,
+			errors.KindUnauthenticated:  errors.AuditOutcomeUserFailure,
+			errors.KindPermissionDenied: errors.AuditOutcomeUserFailure,
+			errors.KindRateLimited:      errors.AuditOutcomeUserFailure,
+			errors.KindPrecondition:     errors.AuditOutcomeUserFailure,
+			errors.KindCanceled:         errors.AuditOutcomeUserFailure,
+			errors.KindTimeout:          errors.AuditOutcomeSystemFailure,
+			errors.KindUnavailable:      errors.AuditOutcomeSystemFailure,
+			errors.KindUnimplemented:    errors.AuditOutcomeSystemFailure,
+			errors.KindInternal:         errors.AuditOutcomeSystemFailure,
+		} {
+			e := errors.ToAuditEvent(errors.WithKind(errors.New("failed"), kind), "alice")
+
+			require.NotNil(t, e)
+			assert.Equal(t, outcome, e.Outcome, kind)
+			assert.Equal(t, string(kind), e.Reason, kind)
+			assert.Empty(t, e.Resource, kind)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package errors
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// BaggageHeader is the W3C Baggage HTTP header and grpc metadata key, e.g. "tenant=acme,campaign=spring".
+const BaggageHeader = "baggage"
+
+// WithBaggageFields attaches the entries of the W3C Baggage of the incoming requests with the given keys,
+// e.g. "tenant" or "campaign", to the request context, see ContextWithBaggage.
+//
+// The entries then enrich the errors returned by the handlers and the ones wrapped with WrapCtx
+// or EnrichCtx during the request, so the business context of the caller follows the failures.
+func WithBaggageFields(keys ...string) ServerOption {
+	return func(o *serverOptions) {
+		o.baggageKeys = append(o.baggageKeys, keys...)
+	}
+}
+
+// ContextWithBaggage returns ctx with the entries of the W3C Baggage header values with the given keys attached,
+// see ContextWith, in the order of keys. Missing entries are skipped.
+func ContextWithBaggage(ctx context.Context, header []string, keys ...string) context.Context {
+	if len(header) == 0 || len(keys) == 0 {
+		return ctx
+	}
+
+	entries := parseBaggage(header)
+
+	var kv []interface{}
+
+	for _, k := range keys {
+		if v, ok := entries[k]; ok {
+			kv = append(kv, k, v)
+		}
+	}
+
+	return ContextWith(ctx, kv...)
+}
+
+// parseBaggage returns the entries of the W3C Baggage header values, without their properties.
+func parseBaggage(header []string) map[string]string {
+	entries := make(map[string]string)
+
+	for _, h := range header {
+		for _, member := range strings.Split(h, ",") {
+			// Properties of the entry, after ';', are ignored.
+			member, _, _ = strings.Cut(member, ";")
+
+			k, v, ok := strings.Cut(member, "=")
+			if !ok {
+				continue
+			}
+
+			k = strings.TrimSpace(k)
+			if k == "" {
+				continue
+			}
+
+			uv, err := url.PathUnescape(strings.TrimSpace(v))
+			if err != nil {
+				continue
+			}
+
+			entries[k] = uv
+		}
+	}
+
+	return entries
+}
+
+// baggageContext returns ctx with the baggage entries selected by WithBaggageFields attached.
+func (o *serverOptions) baggageContext(ctx context.Context, header []string) context.Context {
+	if len(o.baggageKeys) == 0 {
+		return ctx
+	}
+
+	return ContextWithBaggage(ctx, header, o.baggageKeys...)
+}
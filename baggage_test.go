@@ -0,0 +1,67 @@
+package errors_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestContextWithBaggage(t *testing.T) {
+	t.Parallel()
+
+	ctx := errors.ContextWithBaggage(context.Background(),
+		[]string{"tenant=acme;ttl=60, user=joe", "campaign=spring%20sale,invalid"},
+		"campaign", "tenant", "missing")
+
+	assert.Equal(t, []interface{}{"campaign", "spring sale", "tenant", "acme"}, errors.ContextTuples(ctx))
+
+	bg := context.Background()
+	assert.Equal(t, bg, errors.ContextWithBaggage(bg, nil, "tenant"))
+}
+
+func TestWithBaggageFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("grpc", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := unaryServerInterceptor(t, errors.WithBaggageFields("tenant"))
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(errors.BaggageHeader, "tenant=acme,user=joe"))
+
+		_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, errors.WrapCtx(ctx, errors.New("failed"), "handle")
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		assert.Equal(t, map[string]interface{}{"tenant": "acme"}, errors.Fields(fromStatus(t, st)))
+	})
+
+	t.Run("http", func(t *testing.T) {
+		t.Parallel()
+
+		var tuples []interface{}
+
+		h := handler(t, func(_ http.ResponseWriter, r *http.Request) error {
+			tuples = errors.ContextTuples(r.Context())
+
+			return nil
+		}, errors.WithBaggageFields("tenant"))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(errors.BaggageHeader, "tenant=acme")
+
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, []interface{}{"tenant", "acme"}, tuples)
+	})
+}
@@ -0,0 +1,42 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// CapabilitiesMetadataKey is the grpc metadata key the client interceptors advertise their capabilities with,
+// a comma separated list, e.g. "chain".
+const CapabilitiesMetadataKey = "x-dohernandez-errors-capabilities"
+
+// Capabilities of the clients.
+const (
+	// CapabilityChain is advertised by clients recreating the error chain from the status details.
+	CapabilityChain = "chain"
+)
+
+// clientCapabilities are the capabilities advertised by the client interceptors.
+var clientCapabilities = strings.Join([]string{CapabilityChain}, ",")
+
+// advertiseCapabilities returns ctx with the capabilities of the client interceptors in the outgoing metadata.
+func advertiseCapabilities(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, CapabilitiesMetadataKey, clientCapabilities)
+}
+
+// HasCapability reports whether the client of the incoming request of ctx advertised the capability,
+// see CapabilitiesMetadataKey.
+func HasCapability(ctx context.Context, capability string) bool {
+	for _, v := range metadata.ValueFromIncomingContext(ctx, CapabilitiesMetadataKey) {
+		for _, c := range strings.Split(v, ",") {
+			if strings.TrimSpace(c) == capability {
+				return true
+			}
+		}
+	}
+
+	return false
+}
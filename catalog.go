@@ -0,0 +1,51 @@
+package errors
+
+import (
+	"sort"
+
+	"google.golang.org/grpc/codes"
+)
+
+// CatalogEntry describes an error of the catalog, see Catalog.
+type CatalogEntry struct {
+	// Err is the sentinel error.
+	Err error
+	// Name is the name the sentinel is registered under, see NewSentinel.
+	Name    string
+	Message string
+	// Code and HTTPStatus are the codes of the sentinel, see CodeOf, HTTPStatusOf and RegisterCode.
+	Code       codes.Code
+	HTTPStatus int
+	Kind       Kind
+	Retryable  bool
+}
+
+// Catalog returns the catalog of the errors the service can emit, made of the sentinels declared
+// with NewSentinel, ordered by name, with their codes, see RegisterCode, kind and retryability.
+//
+// The catalog is served over grpc by the server of NewCatalogServer, so client teams and tooling
+// can query it.
+func Catalog() []CatalogEntry {
+	sentinels.RLock()
+
+	entries := make([]CatalogEntry, 0, len(sentinels.byName))
+
+	for _, s := range sentinels.byName {
+		entries = append(entries, CatalogEntry{Err: s, Name: s.name, Message: s.message})
+	}
+
+	sentinels.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	for i, e := range entries {
+		entries[i].Code = CodeOf(e.Err)
+		entries[i].HTTPStatus = HTTPStatusOf(e.Err)
+		entries[i].Kind = KindOf(e.Err)
+		entries[i].Retryable = IsRetryable(e.Err)
+	}
+
+	return entries
+}
@@ -0,0 +1,49 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"context"
+
+	errorsv1 "github.com/dohernandez/errors/proto/dohernandez/errors/v1"
+)
+
+// NewCatalogServer returns the server of the dohernandez.errors.v1.ErrorCatalogService, serving the catalog
+// of the errors the service can emit, see Catalog. The user-facing messages are provided by the Translator,
+// see SetTranslator.
+//
+// The service is optional, register it on the grpc server to expose the catalog:
+//
+//	errorsv1.RegisterErrorCatalogServiceServer(srv, errors.NewCatalogServer())
+func NewCatalogServer() errorsv1.ErrorCatalogServiceServer {
+	return catalogServer{}
+}
+
+type catalogServer struct {
+	errorsv1.UnimplementedErrorCatalogServiceServer
+}
+
+// ListErrors implements errorsv1.ErrorCatalogServiceServer.
+func (catalogServer) ListErrors(_ context.Context, req *errorsv1.ListErrorsRequest) (*errorsv1.ListErrorsResponse, error) {
+	catalog := Catalog()
+	resp := &errorsv1.ListErrorsResponse{Errors: make([]*errorsv1.CatalogEntry, 0, len(catalog))}
+
+	for _, e := range catalog {
+		m := &errorsv1.CatalogEntry{
+			Name:       e.Name,
+			Message:    e.Message,
+			Code:       int32(e.Code),       //nolint:gosec
+			HttpStatus: int32(e.HTTPStatus), //nolint:gosec
+			Kind:       string(e.Kind),
+			Retryable:  e.Retryable,
+		}
+
+		if msg, ok := LocalizedMessage(e.Err, req.GetLocale()); ok {
+			m.LocalizedMessage = msg
+		}
+
+		resp.Errors = append(resp.Errors, m)
+	}
+
+	return resp, nil
+}
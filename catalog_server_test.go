@@ -0,0 +1,42 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+	errorsv1 "github.com/dohernandez/errors/proto/dohernandez/errors/v1"
+)
+
+//nolint:paralleltest // Changes the translator.
+func TestNewCatalogServer(t *testing.T) {
+	errors.SetTranslator(translator{"es-ES/already exists": "Ya existe"})
+	defer errors.SetTranslator(nil)
+
+	resp, err := errors.NewCatalogServer().ListErrors(context.Background(), &errorsv1.ListErrorsRequest{Locale: "es-ES"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetErrors(), len(errors.Catalog()))
+
+	var entry *errorsv1.CatalogEntry
+
+	for _, e := range resp.GetErrors() {
+		if e.GetName() == "errors_test.CatalogConflict" {
+			entry = e
+		}
+	}
+
+	require.NotNil(t, entry)
+	assert.Equal(t, "already exists", entry.GetMessage())
+	assert.Equal(t, int32(codes.AlreadyExists), entry.GetCode())
+	assert.Equal(t, int32(http.StatusConflict), entry.GetHttpStatus())
+	assert.Equal(t, string(errors.KindConflict), entry.GetKind())
+	assert.False(t, entry.GetRetryable())
+	assert.Equal(t, "Ya existe", entry.GetLocalizedMessage())
+}
@@ -0,0 +1,63 @@
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+var errCatalogConflict = errors.NewSentinel("errors_test.CatalogConflict", "already exists")
+
+func init() {
+	errors.RegisterCode(errCatalogConflict, codes.AlreadyExists, 0)
+}
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := errors.Catalog()
+
+	var names []string
+
+	for _, e := range catalog {
+		names = append(names, e.Name)
+	}
+
+	assert.IsIncreasing(t, names, "ordered by name")
+	assert.Contains(t, names, "errors.UnknownCodec", "sentinels of the package")
+
+	entry := func(name string) errors.CatalogEntry {
+		for _, e := range catalog {
+			if e.Name == name {
+				return e
+			}
+		}
+
+		require.Failf(t, "entry not found", "name %s", name)
+
+		return errors.CatalogEntry{}
+	}
+
+	assert.Equal(t, errors.CatalogEntry{
+		Err:        errCatalogConflict,
+		Name:       "errors_test.CatalogConflict",
+		Message:    "already exists",
+		Code:       codes.AlreadyExists,
+		HTTPStatus: http.StatusConflict,
+		Kind:       errors.KindConflict,
+	}, entry("errors_test.CatalogConflict"))
+
+	assert.Equal(t, errors.CatalogEntry{
+		Err:        errUserNotFound,
+		Name:       "errors_test.UserNotFound",
+		Message:    "not found",
+		Code:       codes.Unknown,
+		HTTPStatus: http.StatusInternalServerError,
+		Kind:       errors.KindUnknown,
+	}, entry("errors_test.UserNotFound"), "without registered codes")
+}
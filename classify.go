@@ -0,0 +1,86 @@
+package errors
+
+// Classification is the privacy class of a field value, see FieldC.
+type Classification string
+
+// Classifications of field values.
+const (
+	// PII is personally identifiable information, e.g. an email address or a name.
+	PII Classification = "pii"
+	// Sensitive is confidential information that does not identify a person, e.g. an account balance.
+	Sensitive Classification = "sensitive"
+)
+
+// FieldPolicy is how the values of a Classification are serialized, see WithFieldPolicy.
+type FieldPolicy int
+
+// Field policies.
+const (
+	// RedactField serializes the value as RedactedValue, it is the default policy.
+	RedactField FieldPolicy = iota
+	// KeepField serializes the actual value.
+	KeepField
+	// HashField serializes the hex encoded HMAC-SHA256 of the value computed with the key of WithHashKey,
+	// the value is redacted without key.
+	HashField
+	// DropField omits the field.
+	DropField
+)
+
+// Classified is a field value tagged with its Classification, see FieldC.
+//
+// Like a Secret, it is printed, marshaled to JSON and logged as RedactedValue. The serializers of the package,
+// e.g. SlogAttrs, ToStatus, the problem details and the codecs, apply the policy of its classification instead,
+// see WithFieldPolicy.
+type Classified struct {
+	Secret
+
+	Class Classification
+}
+
+// FieldC returns the key-value pair of a field value tagged with its classification, to pass it to Enrich
+// and similar functions.
+//
+//	errors.Enrich(err, errors.FieldC("email", email, errors.PII)...)
+func FieldC(key string, v any, c Classification) []interface{} {
+	return []interface{}{key, Classified{Secret: Redact(v), Class: c}}
+}
+
+// WithFieldPolicy sets the policy applied to the values of classification c when serialized,
+// see Classified. The values are redacted by default.
+func WithFieldPolicy(c Classification, p FieldPolicy) Option {
+	return func(o *options) {
+		if o.fieldPolicies == nil {
+			o.fieldPolicies = make(map[Classification]FieldPolicy)
+		}
+
+		o.fieldPolicies[c] = p
+	}
+}
+
+// WithHashKey sets the key of the HMAC-SHA256 hashing the values of the HashField policy.
+func WithHashKey(key []byte) Option {
+	return func(o *options) {
+		o.anonymizeKey = key
+	}
+}
+
+// classified returns the serialized value of a classified field, and whether the field is kept.
+func (o *options) classified(c Classified) (interface{}, bool) {
+	switch o.fieldPolicies[c.Class] {
+	case KeepField:
+		return c.Reveal(), true
+	case HashField:
+		if len(o.anonymizeKey) == 0 {
+			return RedactedValue, true
+		}
+
+		return o.hash(c.Reveal()), true
+	case DropField:
+		return nil, false
+	case RedactField:
+		return RedactedValue, true
+	default:
+		return RedactedValue, true
+	}
+}
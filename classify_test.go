@@ -0,0 +1,108 @@
+package errors_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestFieldC(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.New("failed"),
+		append(errors.FieldC("email", "jane@example.com", errors.PII), "id", 5)...)
+
+	t.Run("printed", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "map[email:[REDACTED] id:5]", fmt.Sprint(errors.Fields(err)))
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		t.Parallel()
+
+		for name, tc := range map[string]struct {
+			opts  []errors.Option
+			attrs []slog.Attr
+		}{
+			"redacted by default": {
+				attrs: []slog.Attr{slog.String("email", errors.RedactedValue), slog.Int("id", 5)},
+			},
+			"kept": {
+				opts:  []errors.Option{errors.WithFieldPolicy(errors.PII, errors.KeepField)},
+				attrs: []slog.Attr{slog.String("email", "jane@example.com"), slog.Int("id", 5)},
+			},
+			"hashed": {
+				opts: []errors.Option{
+					errors.WithFieldPolicy(errors.PII, errors.HashField),
+					errors.WithHashKey([]byte("secret")),
+				},
+				attrs: []slog.Attr{slog.String("email", hmacHex("secret", "jane@example.com")), slog.Int("id", 5)},
+			},
+			"hashed without key": {
+				opts:  []errors.Option{errors.WithFieldPolicy(errors.PII, errors.HashField)},
+				attrs: []slog.Attr{slog.String("email", errors.RedactedValue), slog.Int("id", 5)},
+			},
+			"dropped": {
+				opts:  []errors.Option{errors.WithFieldPolicy(errors.PII, errors.DropField)},
+				attrs: []slog.Attr{slog.Int("id", 5)},
+			},
+			"other classification": {
+				opts:  []errors.Option{errors.WithFieldPolicy(errors.Sensitive, errors.KeepField)},
+				attrs: []slog.Attr{slog.String("email", errors.RedactedValue), slog.Int("id", 5)},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				attrs := errors.SlogAttrs(err, append(tc.opts, errors.WithVerbosity(errors.VerbosityDetailed))...)
+				assert.Equal(t, append([]slog.Attr{slog.String("message", "failed")}, tc.attrs...), attrs)
+			})
+		}
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		t.Parallel()
+
+		env := errors.NewEnvelope(err)
+		assert.Equal(t, map[string]interface{}{"email": errors.RedactedValue, "id": 5}, env.Fields)
+		assert.Equal(t, []interface{}{"email", errors.RedactedValue, "id", 5}, env.Chain.Fields)
+
+		env = errors.NewEnvelope(err, errors.WithFieldPolicy(errors.PII, errors.DropField))
+		assert.Equal(t, map[string]interface{}{"id": 5}, env.Fields)
+		assert.Equal(t, []interface{}{"id", 5}, env.Chain.Fields)
+	})
+
+	t.Run("problem", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+
+		handler(t, func(http.ResponseWriter, *http.Request) error {
+			return errors.WithCode(err, codes.InvalidArgument)
+		}, errors.WithProblemFields()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.JSONEq(t,
+			`{"type":"about:blank","title":"Bad Request","status":400,"detail":"failed","email":"[REDACTED]","id":5}`,
+			rec.Body.String())
+	})
+}
+
+// hmacHex returns the hex encoded HMAC-SHA256 of value computed with key.
+func hmacHex(key, value string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	_, _ = mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time to the time-dependent features of the package, e.g. WithTimeout.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+// Now returns time.Now.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type clockHolder struct {
+	Clock
+}
+
+var clock atomic.Pointer[clockHolder]
+
+// SetClock sets the Clock used by the package, nil restores the system clock.
+//
+// It is meant to make time-dependent features deterministic in tests.
+func SetClock(c Clock) {
+	if c == nil {
+		c = systemClock{}
+	}
+
+	clock.Store(&clockHolder{Clock: c})
+}
+
+// now returns the current time of the package Clock.
+func now() time.Time {
+	if c := clock.Load(); c != nil {
+		return c.Now()
+	}
+
+	return time.Now()
+}
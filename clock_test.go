@@ -0,0 +1,40 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+// stepClock is a Clock moving forward by step on each call.
+type stepClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *stepClock) Now() time.Time {
+	c.now = c.now.Add(c.step)
+
+	return c.now
+}
+
+//nolint:paralleltest // Changes the package clock.
+func TestSetClock(t *testing.T) {
+	errors.SetClock(&stepClock{step: time.Minute})
+	defer errors.SetClock(nil)
+
+	err := errors.WithTimeout(context.Background(), "fetch", time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+	require.Error(t, err)
+
+	errKV, ok := err.(enrichedError)
+	require.True(t, ok, "error does not implement enrichedError interface")
+	require.Equal(t, time.Minute, errKV.Fields()["elapsed"])
+}
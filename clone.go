@@ -0,0 +1,67 @@
+package errors
+
+// Clone returns an independent copy of the error chain of err.
+//
+// The wrappers of the package are copied along with the key-value pairs, while messages, stack traces
+// and leaf errors, which are never modified, are shared. Errors of other packages can not be copied,
+// they are shared with the errors they wrap.
+// If err is nil, Clone returns nil.
+func Clone(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *withMessage:
+		return &withMessage{message: e.message, err: Clone(e.err), stack: e.stack}
+	case *withError:
+		return &withError{message: e.message, err: Clone(e.err), cause: Clone(e.cause), stack: e.stack}
+	case *enrichedError:
+		return Enrich(Clone(e.err), e.keysAndValues...)
+	case *staleError:
+		return &staleError{err: Clone(e.err), cachedAt: e.cachedAt}
+	case *sharedError:
+		return &sharedError{err: Clone(e.err), caller: e.caller}
+	case *MultiError:
+		errs := make([]error, len(e.Errors))
+		for i, jErr := range e.Errors {
+			errs[i] = Clone(jErr)
+		}
+
+		return &MultiError{Errors: errs}
+	case *panicError:
+		return &panicError{err: Clone(e.err), info: e.info}
+	case *retryError:
+		return &retryError{err: Clone(e.err), retryable: e.retryable}
+	case *retryAfterError:
+		return &retryAfterError{err: Clone(e.err), after: e.after}
+	case *violationsError:
+		return &violationsError{err: Clone(e.err), violations: e.violations}
+	case *reasonError:
+		return &reasonError{err: Clone(e.err), domain: e.domain, reason: e.reason}
+	case *localizedError:
+		return &localizedError{err: Clone(e.err), locale: e.locale, message: e.message}
+	case *frozenError:
+		// A copy is not frozen, it can be modified by its consumer.
+		return Clone(e.err)
+	case *withCode:
+		return &withCode{err: Clone(e.err), code: e.code}
+	case *withHTTPStatus:
+		return &withHTTPStatus{err: Clone(e.err), status: e.status}
+	case *kindError:
+		return &kindError{err: Clone(e.err), kind: e.kind}
+	case *opError:
+		return &opError{err: Clone(e.err), op: e.op}
+	case cloner:
+		// Wrappers of the optional integrations, e.g. statusError.
+		return e.clone()
+	default:
+		return err
+	}
+}
+
+// cloner is implemented by the wrappers defined by the files excluded from some builds, see Clone.
+type cloner interface {
+	clone() error
+}
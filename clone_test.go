@@ -0,0 +1,39 @@
+package errors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, errors.Clone(nil))
+
+	errFailed := errors.New("failed")
+	errOops := errors.New("oops")
+
+	err := errors.EnrichWrapError(
+		errors.Enrich(errors.Wrap(errFailed, "read"), "path", "/tmp"),
+		errors.WithStaleness(errOops, time.Now()),
+		"id", 5,
+	)
+
+	cErr := errors.Clone(err)
+	require.NotSame(t, err, cErr)
+	require.Equal(t, err.Error(), cErr.Error())
+	require.ErrorIs(t, cErr, errFailed)
+	require.ErrorIs(t, cErr, errOops)
+	require.True(t, errors.IsStale(cErr, 0))
+
+	errKV, ok := cErr.(enrichedError)
+	require.True(t, ok, "error does not implement enrichedError interface")
+	require.Equal(t, []interface{}{"id", 5, "path", "/tmp"}, errKV.Tuples())
+
+	require.NotSame(t, errors.Unwrap(err), errors.Unwrap(cErr))
+	require.NotSame(t, errors.Cause(errors.Unwrap(err)), errors.Cause(errors.Unwrap(cErr)))
+}
@@ -0,0 +1,222 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+)
+
+type withCode struct {
+	err  error
+	code codes.Code
+}
+
+// Error implements the standard library error interface.
+func (wc *withCode) Error() string {
+	return wc.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (wc *withCode) Unwrap() error {
+	return wc.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (wc *withCode) Format(st fmt.State, verb rune) {
+	formatError(wc, st, verb)
+}
+
+// WithCode returns err carrying the grpc code, see CodeOf.
+//
+// If err is nil, WithCode returns nil.
+func WithCode(err error, code codes.Code) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &withCode{
+		err:  err,
+		code: code,
+	}
+}
+
+type withHTTPStatus struct {
+	err    error
+	status int
+}
+
+// Error implements the standard library error interface.
+func (ws *withHTTPStatus) Error() string {
+	return ws.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (ws *withHTTPStatus) Unwrap() error {
+	return ws.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (ws *withHTTPStatus) Format(st fmt.State, verb rune) {
+	formatError(ws, st, verb)
+}
+
+// WithHTTPStatus returns err carrying the HTTP status, see HTTPStatusOf.
+//
+// If err is nil, WithHTTPStatus returns nil.
+func WithHTTPStatus(err error, status int) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &withHTTPStatus{
+		err:    err,
+		status: status,
+	}
+}
+
+// registration is the codes of a sentinel error, see RegisterCode.
+type registration struct {
+	sentinel   error
+	code       codes.Code
+	httpStatus int
+}
+
+var registry struct {
+	sync.RWMutex
+
+	registrations []registration
+}
+
+// RegisterCode registers the grpc code and HTTP status of the errors matching sentinel, see CodeOf and HTTPStatusOf.
+//
+// It is meant to be called at init time, so the mappings are declared once instead of in every interceptor.
+// Registering a sentinel again replaces its codes, an httpStatus of 0 maps from the grpc code.
+func RegisterCode(sentinel error, grpcCode codes.Code, httpStatus int) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for i, r := range registry.registrations {
+		if sameError(r.sentinel, sentinel) {
+			registry.registrations[i] = registration{sentinel: sentinel, code: grpcCode, httpStatus: httpStatus}
+
+			return
+		}
+	}
+
+	registry.registrations = append(registry.registrations, registration{
+		sentinel:   sentinel,
+		code:       grpcCode,
+		httpStatus: httpStatus,
+	})
+}
+
+// registered returns the registration of the first registered sentinel matching err.
+func registered(err error) (registration, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	for _, r := range registry.registrations {
+		if Is(err, r.sentinel) {
+			return r, true
+		}
+	}
+
+	return registration{}, false
+}
+
+// CodeOf returns the grpc code of err, looked up in order:
+//   - the outermost code attached with WithCode,
+//   - the code of the outermost kind attached with WithKind, see Kind.Code,
+//   - the code registered for a sentinel matching err, see RegisterCode,
+//   - the code of the grpc status carried by err,
+//   - codes.Canceled or codes.DeadlineExceeded for context errors,
+//   - codes.Unavailable for network errors, see IsNetwork.
+//
+// If none is found, CodeOf returns codes.Unknown, codes.OK if err is nil.
+func CodeOf(err error) codes.Code {
+	if IsNil(err) {
+		return codes.OK
+	}
+
+	var wc *withCode
+	if As(err, &wc) {
+		return wc.code
+	}
+
+	if k, ok := kindOf(err); ok {
+		return k.Code()
+	}
+
+	if r, ok := registered(err); ok {
+		return r.code
+	}
+
+	if code, ok := statusCode(err); ok {
+		return code
+	}
+
+	switch {
+	case Is(err, context.Canceled):
+		return codes.Canceled
+	case Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case IsNetwork(err):
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
+// HTTPStatusOf returns the HTTP status of err, the outermost status attached with WithHTTPStatus,
+// the status registered for a sentinel matching err, see RegisterCode, or else the status corresponding to CodeOf(err).
+//
+// If err is nil, HTTPStatusOf returns http.StatusOK.
+func HTTPStatusOf(err error) int {
+	if IsNil(err) {
+		return http.StatusOK
+	}
+
+	var ws *withHTTPStatus
+	if As(err, &ws) {
+		return ws.status
+	}
+
+	if r, ok := registered(err); ok && r.httpStatus != 0 {
+		return r.httpStatus
+	}
+
+	return httpStatusFromCode(CodeOf(err))
+}
+
+// httpStatusFromCode maps a grpc code to the corresponding HTTP status.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // Client Closed Request.
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
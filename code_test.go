@@ -0,0 +1,115 @@
+package errors_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "nil", err: nil, code: codes.OK},
+		{name: "without code", err: errFailed, code: codes.Unknown},
+		{name: "with code", err: errors.Wrap(errors.WithCode(errFailed, codes.NotFound), "oops"), code: codes.NotFound},
+		{
+			name: "outermost code",
+			err:  errors.WithCode(errors.WithCode(errFailed, codes.NotFound), codes.Internal),
+			code: codes.Internal,
+		},
+		{name: "canceled", err: errors.Wrap(context.Canceled, "oops"), code: codes.Canceled},
+		{name: "deadline exceeded", err: errors.Wrap(context.DeadlineExceeded, "oops"), code: codes.DeadlineExceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.code, errors.CodeOf(tc.err))
+		})
+	}
+
+	require.NoError(t, errors.WithCode(nil, codes.NotFound))
+}
+
+func TestHTTPStatusOf(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "nil", err: nil, status: http.StatusOK},
+		{name: "without status", err: errFailed, status: http.StatusInternalServerError},
+		{name: "with status", err: errors.Wrap(errors.WithHTTPStatus(errFailed, http.StatusTeapot), "oops"), status: http.StatusTeapot},
+		{name: "from code", err: errors.WithCode(errFailed, codes.NotFound), status: http.StatusNotFound},
+		{
+			name:   "status before code",
+			err:    errors.WithCode(errors.WithHTTPStatus(errFailed, http.StatusGone), codes.NotFound),
+			status: http.StatusGone,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.status, errors.HTTPStatusOf(tc.err))
+		})
+	}
+
+	require.NoError(t, errors.WithHTTPStatus(nil, http.StatusTeapot))
+}
+
+var (
+	errRegistered        = errors.New("registered")
+	errRegisteredNoHTTP  = errors.New("registered without HTTP status")
+	errRegisteredChanged = errors.New("registered and changed")
+)
+
+func init() {
+	errors.RegisterCode(errRegistered, codes.NotFound, http.StatusGone)
+	errors.RegisterCode(errRegisteredNoHTTP, codes.PermissionDenied, 0)
+	errors.RegisterCode(errRegisteredChanged, codes.Internal, 0)
+	errors.RegisterCode(errRegisteredChanged, codes.Aborted, 0)
+}
+
+func TestRegisterCode(t *testing.T) {
+	t.Parallel()
+
+	err := errors.WrapError(errors.New("no rows"), errRegistered)
+	require.Equal(t, codes.NotFound, errors.CodeOf(err))
+	require.Equal(t, http.StatusGone, errors.HTTPStatusOf(err))
+
+	err = errors.WithCode(err, codes.Internal)
+	require.Equal(t, codes.Internal, errors.CodeOf(err))
+
+	err = errors.Wrap(errRegisteredNoHTTP, "oops")
+	require.Equal(t, codes.PermissionDenied, errors.CodeOf(err))
+	require.Equal(t, http.StatusForbidden, errors.HTTPStatusOf(err))
+
+	require.Equal(t, codes.Aborted, errors.CodeOf(errRegisteredChanged))
+
+	t.Run("not comparable", func(t *testing.T) {
+		t.Parallel()
+
+		errMulti := multiError{errors.New("not comparable")}
+
+		require.NotPanics(t, func() {
+			errors.RegisterCode(multiError{errors.New("not comparable other")}, codes.Internal, 0)
+			errors.RegisterCode(errMulti, codes.Unavailable, 0)
+		})
+		require.Equal(t, codes.Unknown, errors.CodeOf(errMulti), "not comparable errors never match")
+	})
+}
@@ -0,0 +1,62 @@
+package errors
+
+import "sync"
+
+// ErrUnknownCodec is returned by Encode and Decode for codecs not registered.
+var ErrUnknownCodec = NewSentinel("errors.UnknownCodec", "unknown codec")
+
+// Codec converts an Envelope to and from a wire format.
+type Codec interface {
+	Marshal(e *Envelope) ([]byte, error)
+	Unmarshal(b []byte) (*Envelope, error)
+}
+
+var codecs = struct {
+	sync.RWMutex
+
+	byName map[string]Codec
+}{
+	byName: map[string]Codec{},
+}
+
+// RegisterCodec registers the codec under name, replacing any codec already registered with that name.
+//
+// The "json" and "proto" codecs are registered by default, except with the errors_minimal build tag.
+func RegisterCodec(name string, c Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+
+	codecs.byName[name] = c
+}
+
+func codec(name string) (Codec, error) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+
+	c, ok := codecs.byName[name]
+	if !ok {
+		return nil, Enrich(ErrUnknownCodec, "codec", name)
+	}
+
+	return c, nil
+}
+
+// Encode encodes err with the codec registered under name, see NewEnvelope.
+func Encode(name string, err error, opts ...Option) ([]byte, error) {
+	c, cErr := codec(name)
+	if cErr != nil {
+		return nil, cErr
+	}
+
+	return c.Marshal(NewEnvelope(err, opts...))
+}
+
+// Decode decodes the Envelope encoded in b with the codec registered under name, Envelope.Err recreates the error.
+func Decode(name string, b []byte) (*Envelope, error) {
+	c, err := codec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Unmarshal(b)
+}
@@ -0,0 +1,71 @@
+package errors_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+type gobCodec struct{}
+
+func (gobCodec) Marshal(e *errors.Envelope) ([]byte, error) {
+	var b bytes.Buffer
+
+	err := gob.NewEncoder(&b).Encode(e)
+
+	return b.Bytes(), err
+}
+
+func (gobCodec) Unmarshal(b []byte) (*errors.Envelope, error) {
+	var e errors.Envelope
+
+	err := gob.NewDecoder(bytes.NewReader(b)).Decode(&e)
+
+	return &e, err
+}
+
+func init() {
+	gob.Register([]interface{}{})
+
+	errors.RegisterCodec("gob", gobCodec{})
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	err := errors.WithCode(errors.EnrichWrapError(errors.New("no rows"), errNotFound, "id", "5"), codes.NotFound)
+
+	for _, name := range append(codecs, "gob") {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(name, err)
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(name, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+			require.EqualError(t, dErr, "not found: no rows")
+			require.ErrorIs(t, dErr, errNotFound)
+
+			require.Equal(t, []interface{}{"id", "5"}, enrichedOf(t, dErr).Tuples())
+		})
+	}
+
+	t.Run("unknown codec", func(t *testing.T) {
+		t.Parallel()
+
+		_, eErr := errors.Encode("avro", err)
+		require.ErrorIs(t, eErr, errors.ErrUnknownCodec)
+
+		_, eErr = errors.Decode("avro", nil)
+		require.ErrorIs(t, eErr, errors.ErrUnknownCodec)
+	})
+}
@@ -0,0 +1,135 @@
+package errors
+
+import "context"
+
+// FromContext returns the error of ctx combined with its cause, nil if ctx is not done.
+//
+// The returned error matches both ctx.Err(), context.Canceled or context.DeadlineExceeded,
+// and the cause given to context.CancelCauseFunc, if any.
+func FromContext(ctx context.Context) error {
+	err := ctx.Err()
+	if err == nil {
+		return nil
+	}
+
+	cause := context.Cause(ctx)
+	//nolint:errorlint
+	if cause == nil || cause == err {
+		return err
+	}
+
+	return WrapError(cause, err)
+}
+
+// WrapCtx returns an error annotating err with the supplied message, like Wrap, enriched with the key-value pairs
+// attached to ctx, see ContextWith.
+//
+// If ctx is done, the cancellation error and cause (see FromContext) are attached as the cause of the returned error,
+// unless err already carries the cause.
+// If err is nil, WrapCtx returns nil.
+func WrapCtx(ctx context.Context, err error, message string) error {
+	err = Wrap(err, message)
+	if err == nil {
+		return nil
+	}
+
+	err = EnrichCtx(ctx, err)
+
+	ctxErr := FromContext(ctx)
+	if ctxErr == nil || Is(err, context.Cause(ctx)) {
+		return err
+	}
+
+	return WrapError(ctxErr, err)
+}
+
+type contextTuplesKey struct{}
+
+// ContextWith returns a copy of ctx carrying keysAndValues, after the ones already attached to ctx,
+// e.g. request-scoped data such as request_id or tenant_id.
+//
+// The key-value pairs are merged into the errors wrapped with WrapCtx or EnrichCtx, and into the errors
+// converted by the server interceptors and the HTTP handlers of the package.
+// If keysAndValues is not a list of key-value pairs, ContextWith returns ctx.
+func ContextWith(ctx context.Context, keysAndValues ...interface{}) context.Context {
+	if len(keysAndValues) == 0 || len(keysAndValues)%2 != 0 {
+		return ctx
+	}
+
+	parent := ContextTuples(ctx)
+	kv := make([]interface{}, 0, len(parent)+len(keysAndValues))
+	kv = append(kv, parent...)
+	kv = append(kv, keysAndValues...)
+
+	return context.WithValue(ctx, contextTuplesKey{}, kv)
+}
+
+// ContextTuples returns the key-value pairs attached to ctx with ContextWith, nil if there are none.
+//
+// The result is shared with ctx, it must not be modified.
+func ContextTuples(ctx context.Context) []interface{} {
+	kv, _ := ctx.Value(contextTuplesKey{}).([]interface{}) //nolint:errcheck
+
+	return kv
+}
+
+// EnrichCtx returns err enriched with the key-value pairs attached to ctx, see ContextWith.
+// The keys err already has are skipped, so enriching again with the same context does not duplicate them.
+//
+// If err is nil, EnrichCtx returns nil.
+func EnrichCtx(ctx context.Context, err error) error {
+	kv := ContextTuples(ctx)
+	if IsNil(err) || len(kv) == 0 {
+		return err
+	}
+
+	existing := Fields(err)
+	if len(existing) == 0 {
+		return Enrich(err, kv...)
+	}
+
+	missing := make([]interface{}, 0, len(kv))
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		if key, ok := kv[i].(string); ok {
+			if _, ok := existing[key]; ok {
+				continue
+			}
+		}
+
+		missing = append(missing, kv[i], kv[i+1])
+	}
+
+	if len(missing) == 0 {
+		return err
+	}
+
+	return Enrich(err, missing...)
+}
+
+// CancelCausef cancels a context with an error, formatted according to a format specifier, as its cause.
+func CancelCausef(cancel context.CancelCauseFunc, format string, args ...interface{}) {
+	cancel(Newf(format, args...))
+}
+
+// CancelCause cancels a context with err, enriched with keysAndValues, as its cause.
+//
+// If err is nil, the context is canceled with context.Canceled as its cause.
+// See Enrich.
+func CancelCause(cancel context.CancelCauseFunc, err error, keysAndValues ...interface{}) {
+	cancel(Enrich(err, keysAndValues...))
+}
+
+// ContextCause returns the cause given to context.CancelCauseFunc when ctx was canceled,
+// so it can be classified with Is or As and its fields read.
+//
+// If ctx is not done or was canceled without an explicit cause, ContextCause returns nil.
+func ContextCause(ctx context.Context) error {
+	cause := context.Cause(ctx)
+	//nolint:errorlint
+	if cause == ctx.Err() {
+		return nil
+	}
+
+	return cause
+}
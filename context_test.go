@@ -0,0 +1,179 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not done", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.FromContext(context.Background()))
+	})
+
+	t.Run("canceled without cause", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := errors.FromContext(ctx)
+		require.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("canceled with cause", func(t *testing.T) {
+		t.Parallel()
+
+		errShutdown := errors.New("shutdown")
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(errShutdown)
+
+		err := errors.FromContext(ctx)
+		require.EqualError(t, err, "context canceled: shutdown")
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorIs(t, err, errShutdown)
+	})
+}
+
+func TestWrapCtx(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	t.Run("not done", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WrapCtx(context.Background(), errFailed, "oops")
+		require.EqualError(t, err, "oops: failed")
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.NoError(t, errors.WrapCtx(ctx, nil, "oops"))
+	})
+
+	t.Run("canceled with cause", func(t *testing.T) {
+		t.Parallel()
+
+		errShutdown := errors.New("shutdown")
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(errShutdown)
+
+		err := errors.WrapCtx(ctx, errFailed, "oops")
+		require.EqualError(t, err, "oops: failed: context canceled: shutdown")
+		require.ErrorIs(t, err, errFailed)
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorIs(t, err, errShutdown)
+	})
+
+	t.Run("error already carries the cause", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := errors.WrapCtx(ctx, ctx.Err(), "oops")
+		require.EqualError(t, err, "oops: context canceled")
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestCancelCause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CancelCausef", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		errors.CancelCausef(cancel, "shutdown in %s", "5s")
+
+		require.EqualError(t, errors.ContextCause(ctx), "shutdown in 5s")
+		require.EqualError(t, errors.FromContext(ctx), "context canceled: shutdown in 5s")
+	})
+
+	t.Run("CancelCause enriched", func(t *testing.T) {
+		t.Parallel()
+
+		errShutdown := errors.New("shutdown")
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		errors.CancelCause(cancel, errShutdown, "reason", "deploy")
+
+		cause := errors.ContextCause(ctx)
+		require.ErrorIs(t, cause, errShutdown)
+
+		errKV, ok := cause.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		require.Equal(t, []interface{}{"reason", "deploy"}, errKV.Tuples())
+	})
+
+	t.Run("ContextCause without cause", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.ContextCause(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.NoError(t, errors.ContextCause(ctx))
+	})
+}
+
+func TestContextWith(t *testing.T) {
+	t.Parallel()
+
+	ctx := errors.ContextWith(context.Background(), "request_id", "abc")
+	ctx = errors.ContextWith(ctx, "tenant_id", 7)
+
+	require.Equal(t, []interface{}{"request_id", "abc", "tenant_id", 7}, errors.ContextTuples(ctx))
+	require.Nil(t, errors.ContextTuples(context.Background()))
+	require.Equal(t, ctx, errors.ContextWith(ctx, "odd"))
+
+	t.Run("WrapCtx", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WrapCtx(ctx, errors.New("failed"), "oops")
+
+		require.EqualError(t, err, "oops: failed")
+		require.Equal(t, map[string]interface{}{"request_id": "abc", "tenant_id": 7}, errors.Fields(err))
+	})
+
+	t.Run("EnrichCtx skips existing keys", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.EnrichCtx(ctx, errors.Enrich(errors.New("failed"), "request_id", "xyz"))
+		err = errors.EnrichCtx(ctx, err)
+
+		require.Equal(t, []interface{}{"tenant_id", 7, "request_id", "xyz"}, errors.Tuples(err))
+		require.NoError(t, errors.EnrichCtx(ctx, nil))
+	})
+
+	t.Run("server interceptor", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := unaryServerInterceptor(t)
+
+		_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+			return nil, errors.New("failed")
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, map[string]interface{}{"request_id": "abc", "tenant_id": float64(7)}, errors.Fields(fromStatus(t, st)))
+	})
+}
@@ -0,0 +1,271 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// grpcStatus returns the grpc status carried by err, nil if there is none.
+func grpcStatus(err error) *status.Status {
+	var se interface{ GRPCStatus() *status.Status }
+	if !As(err, &se) {
+		return nil
+	}
+
+	return se.GRPCStatus()
+}
+
+// statusCode returns the code of the grpc status carried by err, and whether err carries one.
+func statusCode(err error) (codes.Code, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return codes.Unknown, false
+	}
+
+	return st.Code(), true
+}
+
+// statusRetryAfter returns the delay of the errdetails.RetryInfo detail of the grpc status carried by err.
+func statusRetryAfter(err error) (time.Duration, bool) {
+	if ri := retryInfo(grpcStatus(err)); ri != nil {
+		return ri.GetRetryDelay().AsDuration(), true
+	}
+
+	return 0, false
+}
+
+// statusViolations returns the field violations of the errdetails.BadRequest detail of the grpc status carried by err.
+func statusViolations(err error) []FieldViolation {
+	return violationsFromBadRequest(badRequest(grpcStatus(err)))
+}
+
+// statusReason returns the domain and the reason of the errdetails.ErrorInfo detail of the grpc status carried by err.
+func statusReason(err error) (string, string, bool) {
+	if ei := errorInfo(grpcStatus(err)); ei != nil {
+		return ei.GetDomain(), ei.GetReason(), true
+	}
+
+	return "", "", false
+}
+
+// statusStackEntries returns the entries of the errdetails.DebugInfo detail of the grpc status carried by err.
+func statusStackEntries(err error) []string {
+	return debugInfo(grpcStatus(err)).GetStackEntries()
+}
+
+// statusLocalizedMessage returns the locale and the message of the errdetails.LocalizedMessage detail in the locale
+// of the grpc status carried by err.
+func statusLocalizedMessage(err error, locale string) (string, string, bool) {
+	if lm := localizedMessageDetail(grpcStatus(err)); lm != nil && (locale == "" || lm.GetLocale() == locale) {
+		return lm.GetLocale(), lm.GetMessage(), true
+	}
+
+	return "", "", false
+}
+
+// statusOps returns the operations in the details of the grpc status carried by err.
+func statusOps(err error) []string {
+	if s := opsStruct(grpcStatus(err)); s != nil {
+		return opsFromStructpb(s)
+	}
+
+	return nil
+}
+
+// statusPanicInfo returns the panic description in the details of the grpc status carried by err.
+func statusPanicInfo(err error) (PanicInfo, bool) {
+	if s := panicStruct(grpcStatus(err)); s != nil {
+		return panicInfoFromStructpb(s), true
+	}
+
+	return PanicInfo{}, false
+}
+
+// retryInfo returns the errdetails.RetryInfo detail of st, nil if there is none.
+func retryInfo(st *status.Status) *errdetails.RetryInfo {
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			return ri
+		}
+	}
+
+	return nil
+}
+
+// errorInfo returns the errdetails.ErrorInfo detail of st, nil if there is none.
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if ei, ok := d.(*errdetails.ErrorInfo); ok {
+			return ei
+		}
+	}
+
+	return nil
+}
+
+// debugInfo returns the errdetails.DebugInfo detail of st, nil if there is none.
+func debugInfo(st *status.Status) *errdetails.DebugInfo {
+	for _, d := range st.Details() {
+		if di, ok := d.(*errdetails.DebugInfo); ok {
+			return di
+		}
+	}
+
+	return nil
+}
+
+// badRequest returns the errdetails.BadRequest detail of st, nil if there is none.
+func badRequest(st *status.Status) *errdetails.BadRequest {
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			return br
+		}
+	}
+
+	return nil
+}
+
+// violationsFromBadRequest returns the field violations of br, nil if there are none.
+func violationsFromBadRequest(br *errdetails.BadRequest) []FieldViolation {
+	var violations []FieldViolation
+
+	for _, fv := range br.GetFieldViolations() {
+		violations = append(violations, FieldViolation{Field: fv.GetField(), Description: fv.GetDescription()})
+	}
+
+	return violations
+}
+
+// badRequestOf returns the errdetails.BadRequest of the field violations.
+func badRequestOf(violations []FieldViolation) *errdetails.BadRequest {
+	br := &errdetails.BadRequest{FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))}
+
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	return br
+}
+
+// localizedMessageDetail returns the errdetails.LocalizedMessage detail of st, nil if there is none.
+func localizedMessageDetail(st *status.Status) *errdetails.LocalizedMessage {
+	for _, d := range st.Details() {
+		if lm, ok := d.(*errdetails.LocalizedMessage); ok {
+			return lm
+		}
+	}
+
+	return nil
+}
+
+// panicDetailKey is the key of the status detail holding the PanicInfo.
+const panicDetailKey = "dohernandez.errors.v1.panic"
+
+// structpb encodes the panic description as a status detail.
+func (p *PanicInfo) structpb() *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			panicDetailKey: structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"value":       structpb.NewStringValue(p.Value),
+					"fingerprint": structpb.NewStringValue(p.Fingerprint),
+				},
+			}),
+		},
+	}
+}
+
+// panicInfoFromStructpb decodes the panic description of a status detail.
+func panicInfoFromStructpb(s *structpb.Struct) PanicInfo {
+	return PanicInfo{
+		Value:       s.GetFields()["value"].GetStringValue(),
+		Fingerprint: s.GetFields()["fingerprint"].GetStringValue(),
+	}
+}
+
+// panicStruct returns the panic description in the status details, nil if there is none.
+func panicStruct(st *status.Status) *structpb.Struct {
+	for _, d := range st.Details() {
+		s, ok := d.(*structpb.Struct)
+		if !ok {
+			continue
+		}
+
+		if ps := s.GetFields()[panicDetailKey].GetStructValue(); ps != nil {
+			return ps
+		}
+	}
+
+	return nil
+}
+
+// opsDetailKey is the key of the status detail holding the operations of the chain.
+const opsDetailKey = "dohernandez.errors.v1.ops"
+
+// opsStructpb encodes the operations of the chain as a status detail.
+func opsStructpb(ops []string) *structpb.Struct {
+	list := &structpb.ListValue{Values: make([]*structpb.Value, 0, len(ops))}
+	for _, op := range ops {
+		list.Values = append(list.Values, structpb.NewStringValue(op))
+	}
+
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			opsDetailKey: structpb.NewListValue(list),
+		},
+	}
+}
+
+// opsFromStructpb decodes the operations of a status detail.
+func opsFromStructpb(list *structpb.ListValue) []string {
+	ops := make([]string, 0, len(list.GetValues()))
+	for _, v := range list.GetValues() {
+		ops = append(ops, v.GetStringValue())
+	}
+
+	return ops
+}
+
+// opsStruct returns the operations in the status details, nil if there are none.
+func opsStruct(st *status.Status) *structpb.ListValue {
+	for _, d := range st.Details() {
+		s, ok := d.(*structpb.Struct)
+		if !ok {
+			continue
+		}
+
+		if list := s.GetFields()[opsDetailKey].GetListValue(); list != nil {
+			return list
+		}
+	}
+
+	return nil
+}
+
+// kindDetailKey is the key of the status detail holding the kind of the error.
+const kindDetailKey = "dohernandez.errors.v1.kind"
+
+// kindDetail returns the kind in the status details, KindUnknown if there is none.
+func kindDetail(st *status.Status) Kind {
+	for _, d := range st.Details() {
+		s, ok := d.(*structpb.Struct)
+		if !ok {
+			continue
+		}
+
+		if v, ok := s.GetFields()[kindDetailKey]; ok {
+			return Kind(v.GetStringValue())
+		}
+	}
+
+	return KindUnknown
+}
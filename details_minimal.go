@@ -0,0 +1,43 @@
+//go:build errors_minimal
+
+package errors
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// The grpc status details are not available in the minimal mode, errors never carry a grpc status.
+
+func statusCode(error) (codes.Code, bool) {
+	return codes.Unknown, false
+}
+
+func statusRetryAfter(error) (time.Duration, bool) {
+	return 0, false
+}
+
+func statusViolations(error) []FieldViolation {
+	return nil
+}
+
+func statusReason(error) (string, string, bool) {
+	return "", "", false
+}
+
+func statusStackEntries(error) []string {
+	return nil
+}
+
+func statusLocalizedMessage(error, string) (string, string, bool) {
+	return "", "", false
+}
+
+func statusOps(error) []string {
+	return nil
+}
+
+func statusPanicInfo(error) (PanicInfo, bool) {
+	return PanicInfo{}, false
+}
@@ -2,4 +2,18 @@
 //
 // Provides functions that create enriched grpc status.Status with error details,
 // that can be use in the client to recreate the error chain.
+//
+// Errors are immutable, wrapping or enriching an error never modifies it, a new error is returned instead.
+// It is therefore safe to wrap and enrich the same error, e.g. a package-level sentinel, from concurrent goroutines.
+//
+// Building with the errors_minimal tag excludes the heavy integrations, so the core wrapping and enrichment API
+// compiles for constrained targets such as TinyGo and WASM: the grpc interceptors, status conversions
+// and catalog service, the protobuf messages and adapters, the reflection-based EnrichStruct, the json codec
+// with MarshalJSON and UnmarshalJSON, the JSON encoding of Problem with WriteProblem, and the http Handler
+// and HTTPMiddleware.
+// The error semantics are unchanged, errors simply never carry a grpc status in this mode, and IsNil detects
+// the typed nils of pointer-shaped types, e.g. a nil *MyError, without reflection, but not nil slices.
+//
+// The minimal mode still depends on the google.golang.org/grpc/codes package, the type of the codes of CodeOf
+// and WithCode, which imports a few small internal packages of grpc, but not its transport nor protobuf.
 package errors
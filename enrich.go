@@ -0,0 +1,26 @@
+package errors
+
+import "sort"
+
+// EnrichFields returns err enriched with the entries of fields, in the order of their keys, see Enrich.
+//
+// If err is nil, EnrichFields returns nil. If fields is empty, EnrichFields returns err.
+func EnrichFields(err error, fields map[string]interface{}) error {
+	if IsNil(err) || len(fields) == 0 {
+		return err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	kv := make([]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		kv = append(kv, k, fields[k])
+	}
+
+	return Enrich(err, kv...)
+}
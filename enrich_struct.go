@@ -0,0 +1,107 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldTag is the struct tag naming the fields attached by EnrichStruct, e.g. `errors:"user_id"`.
+const FieldTag = "errors"
+
+// EnrichStruct returns err enriched with the exported fields of the struct v, or pointer to struct, in their order,
+// see Enrich.
+//
+// The keys are taken from the FieldTag tag, then from the json tag, then from the field name.
+// Fields tagged "-" are skipped, the ones tagged with the omitempty option are skipped when zero,
+// and the fields of embedded structs are promoted.
+//
+//	type request struct {
+//		UserID   string `errors:"user_id"`
+//		Password string `errors:"-"`
+//	}
+//
+// If err is nil, EnrichStruct returns nil. If v is not a struct, EnrichStruct returns err.
+func EnrichStruct(err error, v any) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return err
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return err
+	}
+
+	kv := structTuples(nil, rv)
+	if len(kv) == 0 {
+		return err
+	}
+
+	return Enrich(err, kv...)
+}
+
+// structTuples appends the key-value pairs of the fields of the struct rv to kv.
+func structTuples(kv []interface{}, rv reflect.Value) []interface{} {
+	rt := rv.Type()
+
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+
+		name, omitEmpty, ok := fieldKey(sf)
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(i)
+
+		if sf.Anonymous && name == "" {
+			for fv.Kind() == reflect.Pointer && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+
+			if fv.Kind() == reflect.Struct {
+				kv = structTuples(kv, fv)
+			}
+
+			continue
+		}
+
+		if !sf.IsExported() || !fv.CanInterface() || (omitEmpty && fv.IsZero()) {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+
+		kv = append(kv, name, fv.Interface())
+	}
+
+	return kv
+}
+
+// fieldKey returns the key of the struct field from its tags, empty when not named by a tag,
+// whether the field has the omitempty option, and false if the field is skipped.
+func fieldKey(sf reflect.StructField) (string, bool, bool) {
+	tag, ok := sf.Tag.Lookup(FieldTag)
+	if !ok {
+		tag = sf.Tag.Get("json")
+	}
+
+	if tag == "-" {
+		return "", false, false
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+
+	return name, strings.Contains(","+opts+",", ",omitempty,"), true
+}
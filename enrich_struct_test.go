@@ -0,0 +1,54 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+type audit struct {
+	Actor string `json:"actor"`
+}
+
+type meta struct {
+	Region string
+}
+
+type request struct {
+	audit
+	*meta
+
+	UserID   string `errors:"user_id" json:"uid"`
+	Email    string `json:"email,omitempty"`
+	Password string `errors:"-"`
+	Attempts int
+	internal string
+}
+
+func TestEnrichStruct(t *testing.T) {
+	t.Parallel()
+
+	req := &request{
+		audit:    audit{Actor: "admin"},
+		meta:     &meta{Region: "eu"},
+		UserID:   "u1",
+		Password: "secret",
+		Attempts: 3,
+		internal: "x",
+	}
+
+	err := errors.EnrichStruct(errors.New("failed"), req)
+
+	assert.Equal(t, []interface{}{"actor", "admin", "Region", "eu", "user_id", "u1", "Attempts", 3}, errors.Tuples(err))
+
+	base := errors.New("failed")
+
+	require.NoError(t, errors.EnrichStruct(nil, req))
+	require.Equal(t, base, errors.EnrichStruct(base, "not a struct"))
+	require.Equal(t, base, errors.EnrichStruct(base, (*request)(nil)))
+}
@@ -0,0 +1,23 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestEnrichFields(t *testing.T) {
+	t.Parallel()
+
+	err := errors.EnrichFields(errors.New("failed"), map[string]interface{}{"op": "get", "id": 5})
+
+	assert.Equal(t, []interface{}{"id", 5, "op", "get"}, errors.Tuples(err))
+
+	base := errors.New("failed")
+
+	require.NoError(t, errors.EnrichFields(nil, map[string]interface{}{"id": 5}))
+	require.Equal(t, base, errors.EnrichFields(base, nil))
+}
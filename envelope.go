@@ -0,0 +1,243 @@
+package errors
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Types of the links of an encoded error chain.
+const (
+	LinkString   = "string"
+	LinkMessage  = "message"
+	LinkError    = "error"
+	LinkEnriched = "enriched"
+	LinkJoin     = "join"
+	LinkSentinel = "sentinel"
+)
+
+// Envelope is the transport-agnostic representation of an error, every converter
+// (grpc status, problem details, codecs) converts errors to and from it.
+type Envelope struct {
+	Message    string     `json:"message"`
+	Code       codes.Code `json:"code"`
+	HTTPStatus int        `json:"http_status"`
+	// Fields are the merged fields of the chain.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+	// Chain is the outermost link of the error chain, nil if unknown.
+	Chain *Link `json:"chain,omitempty"`
+	// RetryAfter is the delay after which the operation can be retried, zero if unknown, see WithRetryAfter.
+	RetryAfter time.Duration `json:"retry_after,omitempty"`
+	// Violations are the invalid fields of the request, see FieldViolations.
+	Violations []FieldViolation `json:"violations,omitempty"`
+	// Domain and Reason are the machine-readable identity of the error, see WithReason.
+	Domain string `json:"domain,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// StackEntries are the frames of the stack trace of the error, one "function file:line" per frame,
+	// only set with WithDebugDetails.
+	StackEntries []string `json:"stack_entries,omitempty"`
+	// Locale and LocalizedMessage are the user-facing message of the error, see WithLocalizedMessage.
+	Locale           string `json:"locale,omitempty"`
+	LocalizedMessage string `json:"localized_message,omitempty"`
+	// Panic describes the recovered panic reported by the error, see Recover.
+	Panic *PanicInfo `json:"panic,omitempty"`
+	// Ops are the operations of the chain, from the outermost to the innermost, see WrapOp.
+	Ops []string `json:"ops,omitempty"`
+	// Kind is the category attached to the error with WithKind.
+	Kind Kind `json:"kind,omitempty"`
+}
+
+// Link is a link of an encoded error chain.
+type Link struct {
+	// Type is the type of the link: LinkString, LinkMessage, LinkError, LinkEnriched, LinkJoin or LinkSentinel.
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	// Name is the name of a sentinel link, see NewSentinel.
+	Name string `json:"name,omitempty"`
+	// Fields are the key-value pairs of an enriched link.
+	Fields []interface{} `json:"fields,omitempty"`
+	// Err is the wrapped error.
+	Err *Link `json:"err,omitempty"`
+	// Cause is the cause of an error link.
+	Cause *Link `json:"cause,omitempty"`
+	// Errs are the errors of a join link.
+	Errs []*Link `json:"errs,omitempty"`
+}
+
+// NewEnvelope returns the envelope of err, nil if err is nil.
+//
+// The messages of the envelope are bounded by Settings.MaxDetailBytes.
+func NewEnvelope(err error, opts ...Option) *Envelope {
+	if IsNil(err) {
+		return nil
+	}
+
+	o := newOptions(opts)
+	retryAfter, _ := RetryAfter(err)
+	domain, reason, _ := ReasonOf(err)
+
+	e := &Envelope{
+		Message:    err.Error(),
+		Code:       CodeOf(err),
+		HTTPStatus: HTTPStatusOf(err),
+		Fields:     o.merge(o.tuples(keysAndValues(err))).fields(),
+		Chain:      encodeLink(err, o),
+		RetryAfter: retryAfter,
+		Violations: Violations(err),
+		Domain:     domain,
+		Reason:     reason,
+		Ops:        Ops(err),
+	}
+
+	e.Locale, e.LocalizedMessage, _ = localizedMessage(err, o.locale)
+
+	e.Kind, _ = kindOf(err)
+
+	if info, ok := PanicInfoOf(err); ok {
+		e.Panic = &info
+	}
+
+	if o.debugDetails {
+		for _, f := range StackTrace(err) {
+			e.StackEntries = append(e.StackEntries, f.Function+" "+f.File+":"+strconv.Itoa(f.Line))
+		}
+	}
+
+	if n := settings.Load().MaxDetailBytes; n > 0 {
+		e = Truncate(n)(e)
+	}
+
+	return e
+}
+
+// Err returns the error recreated from the envelope, nil if the envelope is nil.
+//
+// The links of the chain are recreated with their messages and key-value pairs,
+// without a chain the error only has the envelope message. The retry delay, the field violations, the reason,
+// the localized message, the kind, the grpc code, the HTTP status, the panic description and the operations are kept.
+func (e *Envelope) Err() error {
+	if e == nil {
+		return nil
+	}
+
+	var err error
+
+	if e.Chain != nil {
+		err = e.Chain.decode()
+	} else {
+		err = New(e.Message)
+	}
+
+	if e.RetryAfter > 0 {
+		err = WithRetryAfter(err, e.RetryAfter)
+	}
+
+	if len(e.Violations) > 0 {
+		err = FieldViolations(err, e.Violations...)
+	}
+
+	if e.Reason != "" {
+		err = WithReason(err, e.Domain, e.Reason)
+	}
+
+	if e.LocalizedMessage != "" {
+		err = WithLocalizedMessage(err, e.Locale, e.LocalizedMessage)
+	}
+
+	if e.Kind != KindUnknown {
+		err = WithKind(err, e.Kind)
+	}
+
+	if e.Code != codes.OK && e.Code != codes.Unknown {
+		err = WithCode(err, e.Code)
+	}
+
+	if e.HTTPStatus != 0 {
+		err = WithHTTPStatus(err, e.HTTPStatus)
+	}
+
+	if e.Panic != nil {
+		err = &panicError{err: err, info: *e.Panic}
+	}
+
+	for i := len(e.Ops) - 1; i >= 0; i-- {
+		err = WrapOp(err, Op(e.Ops[i]))
+	}
+
+	return err
+}
+
+// encodeLink encodes err and the errors it wraps.
+func encodeLink(err error, o *options) *Link {
+	l := &Link{
+		Type:    LinkString,
+		Message: err.Error(),
+	}
+
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *errorString:
+	case *sentinelError:
+		l.Type = LinkSentinel
+		l.Name = e.name
+	case *withMessage:
+		l.Type = LinkMessage
+		l.Err = encodeLink(e.err, o)
+	case *withError:
+		l.Type = LinkError
+		l.Err = encodeLink(e.err, o)
+		l.Cause = encodeLink(e.cause, o)
+	case *enrichedError:
+		l.Type = LinkEnriched
+		l.Err = encodeLink(e.err, o)
+		l.Fields = o.tuples(appendResolved(nil, e.keysAndValues))
+	default:
+		if errs := unwrapMulti(err); len(errs) > 0 {
+			l.Type = LinkJoin
+
+			for _, mErr := range errs {
+				l.Errs = append(l.Errs, encodeLink(mErr, o))
+			}
+		} else if uErr := Unwrap(err); uErr != nil {
+			l.Type = LinkMessage
+			l.Err = encodeLink(uErr, o)
+		}
+	}
+
+	return l
+}
+
+// decode recreates the error of the link and the errors it wraps.
+func (l *Link) decode() error {
+	switch l.Type {
+	case LinkMessage:
+		if l.Err != nil {
+			return &withMessage{message: l.Message, err: l.Err.decode()}
+		}
+	case LinkError:
+		if l.Err != nil && l.Cause != nil {
+			return &withError{message: l.Message, err: l.Err.decode(), cause: l.Cause.decode()}
+		}
+	case LinkEnriched:
+		if l.Err != nil {
+			return Enrich(l.Err.decode(), l.Fields...)
+		}
+	case LinkSentinel:
+		// Sentinels not registered on this side are recreated as plain errors.
+		if s, ok := sentinel(l.Name); ok {
+			return s
+		}
+	case LinkJoin:
+		if len(l.Errs) > 0 {
+			errs := make([]error, len(l.Errs))
+			for i, jl := range l.Errs {
+				errs[i] = jl.decode()
+			}
+
+			return &MultiError{Errors: errs}
+		}
+	}
+
+	return &errorString{message: l.Message}
+}
@@ -2,10 +2,12 @@ package errors
 
 import (
 	"fmt"
+	"sync"
 )
 
 type errorString struct {
 	message string
+	stack   stack
 }
 
 // Error implements the standard library error interface.
@@ -17,6 +19,7 @@ func (s *errorString) Error() string {
 func New(message string) error {
 	return &errorString{
 		message: message,
+		stack:   callers(),
 	}
 }
 
@@ -26,18 +29,57 @@ func Newf(format string, args ...interface{}) error {
 
 	return &errorString{
 		message: message,
+		stack:   callers(),
 	}
 }
 
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (s *errorString) Format(st fmt.State, verb rune) {
+	formatError(s, st, verb)
+}
+
 // Is implements future error.Is functionality.
-// An Error is equivalent if err message identical.
+// An Error is equivalent if err message identical, except the sentinels created by NewSentinel.
+//
+// When err is also an errorString, the pointers are compared first to short-circuit the message comparison.
+// Messages of this package's errors are compared without allocating, for any other error the comparison
+// relies on its Error method, which allocates if the message is built on demand.
 func (s *errorString) Is(err error) bool {
-	return s.message == err.Error()
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *errorString:
+		return s == e || s.message == e.message
+	case *sentinelError:
+		// Sentinels only match themselves.
+		return false
+	}
+
+	return s.message == message(err)
+}
+
+// message returns the message of err, reading it directly from this package's errors.
+func message(err error) string {
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *errorString:
+		return e.message
+	case *sentinelError:
+		return e.message
+	case *withMessage:
+		return e.message
+	case *withError:
+		return e.message
+	case *enrichedError:
+		return message(e.err)
+	default:
+		return err.Error()
+	}
 }
 
 type withMessage struct {
 	message string
 	err     error
+	stack   stack
 }
 
 // Error implements the standard library error interface.
@@ -50,21 +92,31 @@ func (wm *withMessage) Unwrap() error {
 	return wm.err
 }
 
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (wm *withMessage) Format(st fmt.State, verb rune) {
+	formatError(wm, st, verb)
+}
+
 // Wrap returns an error annotating
 // at the point Wrap is called, and the supplied message.
 // If err is nil, Wrap returns nil.
 func Wrap(err error, message string) error {
-	if err == nil {
+	if IsNil(err) {
 		return nil
 	}
 
-	msg := fmt.Sprintf("%s: %s", message, err)
+	return wrap(err, message, callers())
+}
+
+func wrap(err error, message string, stack stack) error {
+	msg := message + ": " + err.Error()
 
 	return &withMessage{
 		// message is the full concatenate error message (top to bottom)
 		message: msg,
 		// err is the original error
-		err: err,
+		err:   err,
+		stack: stack,
 	}
 }
 
@@ -72,13 +124,44 @@ func Wrap(err error, message string) error {
 // at the point Wrapf is called, and the supplied message.
 // If err is nil, Wrapf returns nil.
 func Wrapf(err error, format string, args ...any) error {
-	if err == nil {
+	if IsNil(err) {
 		return nil
 	}
 
 	message := fmt.Sprintf(format, args...)
 
-	return Wrap(err, message)
+	return wrap(err, message, callers())
+}
+
+// Errorf returns an error with the formats according to a format specifier, honouring the %w verbs
+// like fmt.Errorf: the errors of the %w operands are wrapped by the returned error.
+// The returned error is an error of this package, it captures the stack trace and works with
+// Enrich, ToStatus and the other features of the package.
+func Errorf(format string, args ...any) error {
+	fErr := fmt.Errorf(format, args...)
+
+	var wrapped error
+
+	//nolint:errorlint
+	switch u := fErr.(type) {
+	case interface{ Unwrap() error }:
+		wrapped = u.Unwrap()
+	case interface{ Unwrap() []error }:
+		wrapped = Join(u.Unwrap()...)
+	}
+
+	if wrapped == nil {
+		return &errorString{
+			message: fErr.Error(),
+			stack:   callers(),
+		}
+	}
+
+	return &withMessage{
+		message: fErr.Error(),
+		err:     wrapped,
+		stack:   callers(),
+	}
 }
 
 type withError struct {
@@ -88,6 +171,7 @@ type withError struct {
 	err error
 	// cause is the original error.
 	cause error
+	stack stack
 }
 
 // Error implements the standard library error interface.
@@ -105,26 +189,41 @@ func (we *withError) Cause() error {
 	return we.cause
 }
 
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (we *withError) Format(st fmt.State, verb rune) {
+	formatError(we, st, verb)
+}
+
 // WrapError returns an error annotating err with cause
 // at the point WrapWithError is called, and the supplied err.
 //
 // If err is nil, WrapError returns supplied err.
 // If supplied err is nil, WrapWithError returns err.
+// If both are nil, WrapError returns nil.
 func WrapError(err error, supplied error) error {
-	if err == nil {
+	return wrapError(err, supplied, callers())
+}
+
+func wrapError(err error, supplied error, stack stack) error {
+	if IsNil(err) {
+		if IsNil(supplied) {
+			return nil
+		}
+
 		return supplied
 	}
 
-	if supplied == nil {
+	if IsNil(supplied) {
 		return err
 	}
 
-	msg := fmt.Sprintf("%s: %s", supplied, err)
+	msg := supplied.Error() + ": " + err.Error()
 
 	return &withError{
 		message: msg,
 		err:     supplied,
 		cause:   err,
+		stack:   stack,
 	}
 }
 
@@ -143,6 +242,16 @@ func (we *withError) Is(target error) bool {
 	return Is(cause, target)
 }
 
+// As implements errors.As functionality, finding the first error that matches target
+// in the supplied error chain and then in the cause chain.
+func (we *withError) As(target any) bool {
+	if As(we.err, target) {
+		return true
+	}
+
+	return As(we.cause, target)
+}
+
 // Cause returns the underlying cause of the error, if possible.
 // An error value has a cause if it implements the following
 // interface:
@@ -167,6 +276,35 @@ func Cause(err error) error {
 	return cause.Cause()
 }
 
+// maxChainDepth bounds the traversal of RootCause, protecting it from cyclic chains.
+const maxChainDepth = 1024
+
+// RootCause returns the deepest error of the chain of err, the original error, following
+// the cause before the wrapped error at every level, see Cause and Unwrap.
+//
+// Cyclic chains are not followed forever, the error reached after a bounded number of levels is returned.
+// If err is nil, RootCause returns nil.
+func RootCause(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	for i := 0; i < maxChainDepth; i++ {
+		next := Cause(err)
+		if next == nil {
+			next = Unwrap(err)
+		}
+
+		if next == nil {
+			return err
+		}
+
+		err = next
+	}
+
+	return err
+}
+
 // tuples is a slice of keys and values, e.g. {"key1", 1, "key2", "val2"}.
 type tuples []interface{}
 
@@ -187,7 +325,7 @@ func (t tuples) fields() map[string]interface{} {
 		if label == "" {
 			label, ok = l.(string)
 			if !ok || label == "" {
-				result["malformedFields"] = []interface{}(t[i:])
+				result[MalformedFieldsKey] = []interface{}(t[i:])
 
 				break
 			}
@@ -198,15 +336,29 @@ func (t tuples) fields() map[string]interface{} {
 	}
 
 	if label != "" {
-		result["malformedFields"] = []interface{}{label}
+		result[MalformedFieldsKey] = []interface{}{label}
 	}
 
 	return result
 }
 
+// inlineTuples is the number of key-value pairs enrichedError stores without allocating a separate slice.
+const inlineTuples = 4
+
 type enrichedError struct {
 	err           error
 	keysAndValues tuples
+
+	// inline backs keysAndValues for the common case of a few fields,
+	// saving the allocation of the slice.
+	inline [2 * inlineTuples]interface{}
+
+	// tuples and fields cache the result of Tuples and Fields,
+	// computed once since errors are immutable.
+	tuplesOnce sync.Once
+	tuples     []interface{}
+	fieldsOnce sync.Once
+	fields     map[string]interface{}
 }
 
 // Error implements the standard library error interface.
@@ -219,9 +371,20 @@ func (ee *enrichedError) Unwrap() error {
 	return ee.err
 }
 
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (ee *enrichedError) Format(st fmt.State, verb rune) {
+	formatError(ee, st, verb)
+}
+
 // Tuples returns structured data of error in form of loosely-typed key-value pairs.
+//
+// The result is computed once and shared between calls, it must not be modified.
 func (ee *enrichedError) Tuples() []interface{} {
-	return keysAndValues(ee)
+	ee.tuplesOnce.Do(func() {
+		ee.tuples = keysAndValues(ee)
+	})
+
+	return ee.tuples
 }
 
 func keysAndValues(err error) []interface{} {
@@ -229,7 +392,11 @@ func keysAndValues(err error) []interface{} {
 
 	//nolint:errorlint
 	if ee, ok := err.(*enrichedError); ok {
-		kv = append(kv, ee.keysAndValues...)
+		kv = appendResolved(kv, ee.keysAndValues)
+	}
+
+	for _, mErr := range unwrapMulti(err) {
+		kv = append(kv, keysAndValues(mErr)...)
 	}
 
 	uErr := Unwrap(err)
@@ -250,8 +417,14 @@ func keysAndValues(err error) []interface{} {
 }
 
 // Fields returns structured data of error as a map.
+//
+// The result is computed once and shared between calls, it must not be modified.
 func (ee *enrichedError) Fields() map[string]interface{} {
-	return ee.keysAndValues.fields()
+	ee.fieldsOnce.Do(func() {
+		ee.fields = tuples(appendResolved(nil, ee.keysAndValues)).fields()
+	})
+
+	return ee.fields
 }
 
 // Enrich takes in a basic error object and appends additional relevant fields, enriching the error message to help
@@ -260,24 +433,115 @@ func (ee *enrichedError) Fields() map[string]interface{} {
 // If err is nil, Enrich returns nil.
 // If keysAndValues is nil, Enrich returns err.
 // If err is enrichedError, the keysAndValues will be appended to the existing keysAndValues.
+// If keysAndValues is not a list of key-value pairs, the well-formed pairs are kept and the remainder
+// is recorded under MalformedFieldsKey, see Settings.MalformedFieldsHook.
+//
+// Values are computed only when the fields are read when wrapped with Lazy.
+//
+// Enrich never modifies err and keeps its own copy of keysAndValues, err is safe to be shared between goroutines.
 func Enrich(err error, keysAndValues ...interface{}) error {
-	if err == nil {
+	if IsNil(err) {
 		return nil
 	}
 
-	// keysAndValues must be a list of key-value pairs.
-	if len(keysAndValues)%2 != 0 {
-		return err
+	ee := &enrichedError{
+		err: err,
 	}
 
-	return &enrichedError{
-		err:           err,
-		keysAndValues: keysAndValues,
+	ee.setKeysAndValues(wellFormed(keysAndValues))
+
+	return ee
+}
+
+// MalformedFieldsKey is the key the remainder of a list of key-value pairs with an odd length is recorded under,
+// e.g. by Enrich.
+const MalformedFieldsKey = "malformedFields"
+
+// wellFormed returns keysAndValues as a list of key-value pairs, the remainder of an odd length list being
+// recorded under MalformedFieldsKey. The misuse is reported to Settings.MalformedFieldsHook.
+func wellFormed(keysAndValues []interface{}) []interface{} {
+	n := len(keysAndValues)
+	if n%2 == 0 {
+		return keysAndValues
 	}
+
+	if hook := settings.Load().MalformedFieldsHook; hook != nil {
+		// The hook gets a copy so keysAndValues does not escape, Enrich would allocate it otherwise.
+		hook(append([]interface{}(nil), keysAndValues...))
+	}
+
+	kv := make([]interface{}, 0, n+1)
+	kv = append(kv, keysAndValues[:n-1]...)
+
+	return append(kv, MalformedFieldsKey, []interface{}{keysAndValues[n-1]})
+}
+
+// setKeysAndValues sets a copy of keysAndValues, stored inline when they fit.
+func (ee *enrichedError) setKeysAndValues(keysAndValues []interface{}) {
+	if len(keysAndValues) <= len(ee.inline) {
+		ee.keysAndValues = ee.inline[:copy(ee.inline[:], keysAndValues)]
+	} else {
+		ee.keysAndValues = append(tuples(nil), keysAndValues...)
+	}
+}
+
+// NewKV returns an error with the supplied message without cause, enriched with keysAndValues,
+// the same as Enrich(New(message), keysAndValues...) in a single allocation.
+//
+// If keysAndValues is not a list of key-value pairs, the remainder is recorded under MalformedFieldsKey, see Enrich.
+func NewKV(message string, keysAndValues ...interface{}) error {
+	return newKV(message, callers(), keysAndValues)
+}
+
+// newKV returns the enriched error of NewKV with the stack trace.
+func newKV(message string, stack stack, keysAndValues []interface{}) error {
+	// The leaf and the enriched error share one allocation.
+	e := &struct {
+		ee enrichedError
+		es errorString
+	}{
+		es: errorString{
+			message: message,
+			stack:   stack,
+		},
+	}
+
+	e.ee.err = &e.es
+	e.ee.setKeysAndValues(wellFormed(keysAndValues))
+
+	return &e.ee
+}
+
+// WrapKV returns an error annotating err with a stack trace at the point WrapKV is called, and the supplied message,
+// enriched with keysAndValues, the same as Enrich(Wrap(err, message), keysAndValues...) in a single allocation.
+//
+// If err is nil, WrapKV returns nil.
+// If keysAndValues is not a list of key-value pairs, the remainder is recorded under MalformedFieldsKey, see Enrich.
+func WrapKV(err error, message string, keysAndValues ...interface{}) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	// The wrapper and the enriched error share one allocation.
+	e := &struct {
+		ee enrichedError
+		wm withMessage
+	}{
+		wm: withMessage{
+			message: message + ": " + err.Error(),
+			err:     err,
+			stack:   callers(),
+		},
+	}
+
+	e.ee.err = &e.wm
+	e.ee.setKeysAndValues(wellFormed(keysAndValues))
+
+	return &e.ee
 }
 
 // EnrichWrapError returns an enrichedError error annotating err with cause.
 // @see WrapWithError and Enrich.
 func EnrichWrapError(err error, supplied error, keysAndValues ...interface{}) error {
-	return Enrich(WrapError(err, supplied), keysAndValues...)
+	return Enrich(wrapError(err, supplied, callers()), keysAndValues...)
 }
@@ -234,3 +234,42 @@ func Example_withError_Is() {
 	// true
 	// false
 }
+
+func ExampleEnrich() {
+	err := errors.New("foo")
+
+	err = errors.Enrich(err, "id", 5, "name", "bar")
+
+	fmt.Println(err)
+
+	errKV, ok := err.(interface{ Tuples() []interface{} })
+	if !ok {
+		return
+	}
+
+	fmt.Println(errKV.Tuples()...)
+
+	// Output:
+	// foo
+	// id 5 name bar
+}
+
+func ExampleEnrichWrapError() {
+	err := errors.New("foo")
+	err = errors.Enrich(err, "id", 5)
+
+	err = errors.EnrichWrapError(err, errors.New("bar"), "name", "baz")
+
+	fmt.Println(err)
+
+	errKV, ok := err.(interface{ Tuples() []interface{} })
+	if !ok {
+		return
+	}
+
+	fmt.Println(errKV.Tuples()...)
+
+	// Output:
+	// bar: foo
+	// name baz id 5
+}
@@ -2,7 +2,10 @@ package errors_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"os"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +24,31 @@ func TestNew(t *testing.T) {
 	assert.EqualError(t, err, expected, "error message mismatch, got %s want %s", err, expected)
 }
 
+func TestNewKV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NewKV with key-value pairs", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.NewKV("failed", "id", 5)
+		require.EqualError(t, err, "failed")
+		require.ErrorIs(t, err, errors.New("failed"))
+		require.ErrorIs(t, errors.New("failed"), err)
+
+		errKV, ok := err.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		assert.Equal(t, []interface{}{"id", 5}, errKV.Tuples())
+	})
+
+	t.Run("NewKV with malformed key-value pairs", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.NewKV("failed", "id")
+		require.EqualError(t, err, "failed")
+		assert.Equal(t, map[string]interface{}{errors.MalformedFieldsKey: []interface{}{"id"}}, errors.Fields(err))
+	})
+}
+
 func TestNewf(t *testing.T) {
 	t.Parallel()
 
@@ -77,6 +105,79 @@ func TestWrapf(t *testing.T) {
 	})
 }
 
+func TestWrapKV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("WrapKV with error", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("failed")
+
+		errWrap := errors.WrapKV(err, "oops", "id", 5)
+		require.EqualError(t, errWrap, "oops: failed")
+		require.ErrorIs(t, errWrap, err)
+		require.EqualError(t, errors.Unwrap(errWrap), "oops: failed")
+
+		errKV, ok := errWrap.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		assert.Equal(t, []interface{}{"id", 5}, errKV.Tuples())
+		assert.Equal(t, map[string]interface{}{"id": 5}, errKV.Fields())
+	})
+
+	t.Run("WrapKV with malformed key-value pairs", func(t *testing.T) {
+		t.Parallel()
+
+		errWrap := errors.WrapKV(errors.New("failed"), "oops", "id")
+		require.EqualError(t, errWrap, "oops: failed")
+		assert.Equal(t, map[string]interface{}{errors.MalformedFieldsKey: []interface{}{"id"}}, errors.Fields(errWrap))
+	})
+
+	t.Run("WrapKV with nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.WrapKV(nil, "oops", "id", 5))
+	})
+}
+
+func TestErrorf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Errorf without %w", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.Errorf("oops id %d", 5)
+		require.EqualError(t, err, "oops id 5")
+		require.NoError(t, errors.Unwrap(err))
+	})
+
+	t.Run("Errorf with %w", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.Enrich(errors.New("failed"), "id", 5)
+
+		errWrap := errors.Errorf("oops id %d: %w", 5, err)
+		require.EqualError(t, errWrap, "oops id 5: failed")
+		require.ErrorIs(t, errWrap, err)
+		assert.Equal(t, map[string]interface{}{"id": 5}, errors.Fields(errWrap))
+
+		errKV, ok := errors.Enrich(errWrap, "op", "get").(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		assert.Equal(t, []interface{}{"op", "get", "id", 5}, errKV.Tuples())
+	})
+
+	t.Run("Errorf with several %w", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("failed")
+		sErr := errors.New("oops")
+
+		errWrap := errors.Errorf("%w: %w", sErr, err)
+		require.EqualError(t, errWrap, "oops: failed")
+		require.ErrorIs(t, errWrap, err)
+		require.ErrorIs(t, errWrap, sErr)
+	})
+}
+
 func TestWrapError(t *testing.T) {
 	t.Parallel()
 
@@ -123,10 +224,22 @@ func TestWrapError(t *testing.T) {
 }
 
 type enrichedError interface {
+	error
 	Tuples() []interface{}
 	Fields() map[string]interface{}
 }
 
+// enrichedOf returns the outermost enriched error of the chain of err.
+func enrichedOf(t *testing.T, err error) enrichedError {
+	t.Helper()
+
+	var errKV enrichedError
+
+	require.True(t, errors.As(err, &errKV), "error does not implement enrichedError interface")
+
+	return errKV
+}
+
 func TestEnriched(t *testing.T) {
 	t.Parallel()
 
@@ -175,8 +288,10 @@ func TestEnriched(t *testing.T) {
 		expected := "failed"
 		require.EqualError(t, errEnriched, expected, "error message mismatch, got %s want %s", errEnriched, expected)
 
-		_, ok := errEnriched.(enrichedError)
-		require.False(t, ok, "error does implement enrichedError interface")
+		// The well-formed pairs are kept, the remainder is recorded.
+		errKV, ok := errEnriched.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		require.Equal(t, []interface{}{"id", "5", errors.MalformedFieldsKey, []interface{}{5}}, errKV.Tuples())
 	})
 
 	t.Run("EnrichWrapWithError error", func(t *testing.T) {
@@ -252,6 +367,80 @@ func TestEnriched(t *testing.T) {
 	})
 }
 
+func TestEnriched_cache(t *testing.T) {
+	t.Parallel()
+
+	errEnriched := errors.EnrichWrapError(errors.Enrich(errors.New("failed"), "id", 5), errors.New("oops"), "name", "foo")
+
+	errKV, ok := errEnriched.(enrichedError)
+	require.True(t, ok, "error does not implement enrichedError interface")
+
+	for i := 0; i < 2; i++ {
+		require.Equal(t, []interface{}{"name", "foo", "id", 5}, errKV.Tuples())
+		require.Equal(t, map[string]interface{}{"name": "foo"}, errKV.Fields())
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun measures the allocations of the whole process.
+func TestEnriched_allocs(t *testing.T) {
+	err := errors.Wrap(errSentinel, "oops")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		errSink = errors.Enrich(err, "id", 5, "name", "foo")
+	})
+
+	require.Equal(t, 1.0, allocs, "the key-value pairs must not escape to the heap")
+}
+
+func TestEnriched_concurrent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Enrich shared error", func(t *testing.T) {
+		t.Parallel()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				errEnriched := errors.Enrich(errors.Enrich(errSentinel, "id", i), "request", i)
+
+				errKV, ok := errEnriched.(enrichedError)
+				assert.True(t, ok, "error does not implement enrichedError interface")
+				assert.Equal(t, []interface{}{"request", i, "id", i}, errKV.Tuples())
+				assert.ErrorIs(t, errEnriched, errSentinel)
+			}(i)
+		}
+
+		wg.Wait()
+
+		_, ok := errSentinel.(enrichedError)
+		require.False(t, ok, "sentinel error should not be enriched")
+	})
+
+	t.Run("Enrich keeps own copy of keysAndValues", func(t *testing.T) {
+		t.Parallel()
+
+		for _, kv := range [][]interface{}{
+			{"id", 5},
+			{"a", 1, "b", 2, "c", 3, "d", 4, "e", 5},
+		} {
+			expected := append([]interface{}(nil), kv...)
+
+			errEnriched := errors.Enrich(errors.New("failed"), kv...)
+
+			kv[1] = "changed"
+
+			errKV, ok := errEnriched.(enrichedError)
+			require.True(t, ok, "error does not implement enrichedError interface")
+			require.Equal(t, expected, errKV.Tuples())
+		}
+	})
+}
+
 func Test_Unwrap(t *testing.T) {
 	t.Parallel()
 
@@ -372,6 +561,55 @@ func Test_Cause(t *testing.T) {
 	})
 }
 
+type cyclicError struct {
+	next error
+}
+
+func (e *cyclicError) Error() string { return "cyclic" }
+
+func (e *cyclicError) Unwrap() error { return e.next }
+
+func Test_RootCause(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("failed")
+
+	testCases := []struct {
+		scenario string
+		err      error
+		expected error
+	}{
+		{scenario: "nil"},
+		{scenario: "errors.New", err: err, expected: err},
+		{scenario: "errors.Wrap", err: errors.Wrap(errors.Wrap(err, "read"), "oops"), expected: err},
+		{
+			scenario: "errors.WrapError",
+			err:      errors.Enrich(errors.WrapError(errors.Wrap(err, "read"), errSentinel), "id", 5),
+			expected: err,
+		},
+		{scenario: "fmt.Errorf", err: fmt.Errorf("read: %w", err), expected: err},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, errors.RootCause(tc.err) == tc.expected) //nolint:errorlint
+		})
+	}
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+
+		cycle := &cyclicError{}
+		cycle.next = errors.Wrap(cycle, "again")
+
+		require.Error(t, errors.RootCause(cycle))
+	})
+}
+
 func Test_Is(t *testing.T) {
 	t.Parallel()
 
@@ -503,3 +741,146 @@ func Test_Is(t *testing.T) {
 		require.NotErrorIs(t, errEnrich, context.Canceled)
 	})
 }
+
+func Test_As(t *testing.T) {
+	t.Parallel()
+
+	t.Run("As for the cause of errors.WrapError", func(t *testing.T) {
+		t.Parallel()
+
+		cause := &os.PathError{Op: "open", Path: "/tmp", Err: os.ErrNotExist}
+		err := errors.Wrap(errors.WrapError(cause, errors.New("oops")), "read")
+
+		var pErr *os.PathError
+		require.ErrorAs(t, err, &pErr)
+		require.Same(t, cause, pErr)
+	})
+
+	t.Run("As prefers the supplied error of errors.WrapError", func(t *testing.T) {
+		t.Parallel()
+
+		supplied := &os.PathError{Op: "stat", Path: "/tmp", Err: os.ErrNotExist}
+		cause := &os.PathError{Op: "open", Path: "/tmp", Err: os.ErrNotExist}
+
+		var pErr *os.PathError
+		require.ErrorAs(t, errors.WrapError(cause, supplied), &pErr)
+		require.Same(t, supplied, pErr)
+	})
+
+	t.Run("no As for errors.WrapError", func(t *testing.T) {
+		t.Parallel()
+
+		var pErr *os.PathError
+		require.False(t, errors.As(errors.WrapError(errors.New("failed"), errors.New("oops")), &pErr))
+	})
+}
+
+var (
+	errSentinel      = errors.New("sentinel failure")
+	errOtherSentinel = errors.New("other sentinel failure")
+)
+
+func BenchmarkIs(b *testing.B) {
+	err := errors.Wrap(errSentinel, "oops")
+
+	b.Run("same sentinel", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if !errors.Is(err, errSentinel) {
+				b.Fatal("error should match")
+			}
+		}
+	})
+
+	b.Run("other sentinel", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if errors.Is(err, errOtherSentinel) {
+				b.Fatal("error should not match")
+			}
+		}
+	})
+
+	b.Run("wrapped target", func(b *testing.B) {
+		target := errors.Enrich(errors.Wrap(errOtherSentinel, "oops"), "id", 5)
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if errors.Is(err, target) {
+				b.Fatal("error should not match")
+			}
+		}
+	})
+
+	b.Run("foreign target", func(b *testing.B) {
+		target := &os.PathError{Op: "open", Path: "/tmp", Err: os.ErrNotExist}
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if errors.Is(err, target) {
+				b.Fatal("error should not match")
+			}
+		}
+	})
+}
+
+func BenchmarkEnrichedError_Tuples(b *testing.B) {
+	err := errors.EnrichWrapError(
+		errors.Enrich(errors.New("failed"), "id", 5),
+		errors.Enrich(errSentinel, "name", "foo"),
+		"hash", "0X0",
+	)
+
+	errKV, ok := err.(enrichedError)
+	if !ok {
+		b.Fatal("error does not implement enrichedError interface")
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = errKV.Tuples()
+		_ = errKV.Fields()
+	}
+}
+
+var errSink error
+
+func BenchmarkEnrich(b *testing.B) {
+	b.Run("few fields", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			errSink = errors.Enrich(errors.Wrap(errSentinel, "oops"), "id", 5, "name", "foo")
+		}
+	})
+
+	b.Run("many fields", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			errSink = errors.Enrich(errors.Wrap(errSentinel, "oops"), "a", 1, "b", 2, "c", 3, "d", 4, "e", 5)
+		}
+	})
+}
+
+func BenchmarkNewKV(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		errSink = errors.NewKV("failed", "id", 5, "name", "foo")
+	}
+}
+
+func BenchmarkWrapKV(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		errSink = errors.WrapKV(errSentinel, "oops", "id", 5, "name", "foo")
+	}
+}
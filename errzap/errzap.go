@@ -0,0 +1,140 @@
+// Package errzap provides zap fields and marshalers for the errors of github.com/dohernandez/errors,
+// so the key-value pairs enriching the errors flow into zap logs.
+//
+// It is a separate module, so only its users depend on zap.
+package errzap
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/dohernandez/errors"
+)
+
+// Fields returns the zap fields of err: its message under the "error" key and its key-value pairs.
+//
+//	logger.Error("failed", errzap.Fields(err)...)
+//
+// The options, e.g. errors.WithVerbosity, are passed to errors.SlogAttrs.
+func Fields(err error, opts ...errors.Option) []zap.Field {
+	attrs := errors.SlogAttrs(err, opts...)
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	fields := make([]zap.Field, 0, len(attrs))
+	fields = append(fields, zap.String("error", attrs[0].Value.String()))
+
+	for _, a := range attrs[1:] {
+		if a.Key == "chain" {
+			continue
+		}
+
+		fields = append(fields, zap.Any(a.Key, a.Value.Any()))
+	}
+
+	return fields
+}
+
+// Error returns a zap field logging err as an object under the "error" key, see Object.
+func Error(err error, opts ...errors.Option) zap.Field {
+	return zap.Object("error", Object(err, opts...))
+}
+
+// Object returns a zapcore.ObjectMarshaler of err, marshaling its message, key-value pairs
+// and the messages of its chain, see errors.SlogAttrs.
+func Object(err error, opts ...errors.Option) zapcore.ObjectMarshaler {
+	return object{err: err, opts: opts}
+}
+
+type object struct {
+	err  error
+	opts []errors.Option
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (o object) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, a := range errors.SlogAttrs(o.err, o.opts...) {
+		if err := marshalAttr(enc, a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func marshalAttr(enc zapcore.ObjectEncoder, a slog.Attr) error {
+	if a.Value.Kind() != slog.KindGroup {
+		zap.Any(a.Key, a.Value.Any()).AddTo(enc)
+
+		return nil
+	}
+
+	return enc.AddObject(a.Key, zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		for _, ga := range a.Value.Group() {
+			if err := marshalAttr(enc, ga); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
+
+// NewCore returns a zapcore.Core logging the error fields, e.g. zap.Error(err) or Error(err),
+// as objects marshaled with the options, e.g. errors.WithVerbosity, before passing them to core.
+//
+// It sets the verbosity per logger, e.g. full to a file sink and brief to stdout:
+//
+//	file := zap.New(errzap.NewCore(fileCore, errors.WithVerbosity(errors.VerbosityFull)))
+func NewCore(core zapcore.Core, opts ...errors.Option) zapcore.Core {
+	return verbosityCore{Core: core, opts: opts}
+}
+
+type verbosityCore struct {
+	zapcore.Core
+
+	opts []errors.Option
+}
+
+// With implements zapcore.Core.
+func (c verbosityCore) With(fields []zapcore.Field) zapcore.Core {
+	return verbosityCore{Core: c.Core.With(c.fields(fields)), opts: c.opts}
+}
+
+// Check implements zapcore.Core.
+func (c verbosityCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+
+	return ce
+}
+
+// Write implements zapcore.Core.
+func (c verbosityCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(e, c.fields(fields))
+}
+
+// fields returns the fields with the error fields marshaled with the options.
+func (c verbosityCore) fields(fields []zapcore.Field) []zapcore.Field {
+	replaced := make([]zapcore.Field, len(fields))
+
+	for i, f := range fields {
+		replaced[i] = f
+
+		switch v := f.Interface.(type) {
+		case object:
+			// The options of the field take precedence over the ones of the core.
+			replaced[i] = zap.Object(f.Key, object{err: v.err, opts: append(append([]errors.Option{}, c.opts...), v.opts...)})
+		case error:
+			if f.Type == zapcore.ErrorType {
+				replaced[i] = zap.Object(f.Key, object{err: v, opts: c.opts})
+			}
+		}
+	}
+
+	return replaced
+}
@@ -0,0 +1,88 @@
+package errzap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/dohernandez/errors"
+	"github.com/dohernandez/errors/errzap"
+)
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, errzap.Fields(nil))
+
+	err := errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5, "path", "/tmp")
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	zap.New(core).Error("oops", errzap.Fields(err)...)
+
+	require.Equal(t, map[string]interface{}{
+		"error": "read: failed",
+		"id":    int64(5),
+		"path":  "/tmp",
+	}, logs.All()[0].ContextMap())
+}
+
+func TestFields_classified(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.New("failed"), append(errors.FieldC("email", "jane@example.com", errors.PII), "id", 5)...)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	zap.New(core).Error("oops", errzap.Fields(err)...)
+	zap.New(core).Error("oops", errzap.Fields(err, errors.WithFieldPolicy(errors.PII, errors.KeepField))...)
+
+	require.Equal(t, map[string]interface{}{
+		"error": "failed",
+		"email": errors.RedactedValue,
+		"id":    int64(5),
+	}, logs.All()[0].ContextMap())
+	require.Equal(t, "jane@example.com", logs.All()[1].ContextMap()["email"])
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	zap.New(core).Error("oops", errzap.Error(err))
+
+	require.Equal(t, map[string]interface{}{
+		"error": map[string]interface{}{
+			"message": "read: failed",
+			"id":      int64(5),
+			"chain":   map[string]interface{}{"0": "read: failed", "1": "failed"},
+		},
+	}, logs.All()[0].ContextMap())
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5)
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(errzap.NewCore(core, errors.WithVerbosity(errors.VerbosityBrief)))
+
+	logger.With(zap.Error(err)).Error("oops", errzap.Error(err))
+	logger.Error("detailed", zap.Object("cause", errzap.Object(err, errors.WithVerbosity(errors.VerbosityDetailed))))
+
+	require.Equal(t, map[string]interface{}{
+		"error": map[string]interface{}{"message": "read"},
+	}, logs.All()[0].ContextMap())
+
+	require.Equal(t, map[string]interface{}{
+		"cause": map[string]interface{}{
+			"message": "read: failed",
+			"id":      int64(5),
+			"chain":   map[string]interface{}{"0": "read: failed", "1": "failed"},
+		},
+	}, logs.All()[1].ContextMap())
+}
@@ -0,0 +1,23 @@
+module github.com/dohernandez/errors/errzap
+
+go 1.23.3
+
+require (
+	github.com/dohernandez/errors v0.1.0
+	github.com/stretchr/testify v1.10.0
+	go.uber.org/zap v1.28.0
+)
+
+require (
+	github.com/bool64/dev v0.2.36 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.26.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250818200422-3122310a409c // indirect
+	google.golang.org/grpc v1.75.1 // indirect
+	google.golang.org/protobuf v1.36.9 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
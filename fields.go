@@ -0,0 +1,167 @@
+package errors
+
+import "time"
+
+// Tuples returns the key-value pairs of all enriched errors in the chain of err,
+// from the outermost to the innermost, nil if there are none.
+//
+// The chain is walked via Unwrap and Cause, so the pairs are found behind any wrapper.
+func Tuples(err error) []interface{} {
+	if IsNil(err) {
+		return nil
+	}
+
+	return keysAndValues(err)
+}
+
+// Fields returns the key-value pairs of all enriched errors in the chain of err as a map,
+// nil if there are none, see Tuples.
+//
+// When a key is set more than once, the innermost value wins, unless another policy is set with WithMergePolicy.
+func Fields(err error, opts ...Option) map[string]interface{} {
+	return newOptions(opts).merge(Tuples(err)).fields()
+}
+
+// DedupTuples returns the key-value pairs of all enriched errors in the chain of err, see Tuples,
+// with every key once, in the order of its first occurrence from the outermost error.
+//
+// When a key is set more than once, the innermost value wins, unless another policy is set with WithMergePolicy.
+func DedupTuples(err error, opts ...Option) []interface{} {
+	if IsNil(err) {
+		return nil
+	}
+
+	return newOptions(opts).merge(Tuples(err))
+}
+
+// MergePolicy decides which value wins when a key is set at several levels of the chain of an error.
+type MergePolicy int
+
+// Merge policies.
+const (
+	// MergeInnermost keeps the value set closest to the root cause, the default.
+	MergeInnermost MergePolicy = iota
+	// MergeOutermost keeps the value set closest to the caller, e.g. to let callers override the fields.
+	MergeOutermost
+)
+
+// dedup returns the key-value pairs with every key once, in the order of its first occurrence, keeping the value
+// selected by policy. The pairs after a key that is not a string are kept as they are.
+func (t tuples) dedup(policy MergePolicy) tuples {
+	if len(t) < 4 {
+		return t
+	}
+
+	index := make(map[string]int, len(t)/2)
+	result := make(tuples, 0, len(t))
+
+	for i := 0; i < len(t); i += 2 {
+		key, ok := t[i].(string)
+		if !ok || key == "" || i+1 == len(t) {
+			return append(result, t[i:]...)
+		}
+
+		j, seen := index[key]
+		if !seen {
+			index[key] = len(result)
+			result = append(result, key, t[i+1])
+
+			continue
+		}
+
+		if policy == MergeInnermost {
+			result[j+1] = t[i+1]
+		}
+	}
+
+	return result
+}
+
+// Field returns the value of the field key of the chain of err, see Fields, and whether it is set and of type T.
+//
+//	userID, ok := errors.Field[uuid.UUID](err, "user_id")
+func Field[T any](err error, key string) (T, bool) {
+	v, _ := field(err, key)
+	t, ok := v.(T)
+
+	return t, ok
+}
+
+// FieldString returns the string value of the field key of the chain of err, and whether it is set and a string.
+func FieldString(err error, key string) (string, bool) {
+	return Field[string](err, key)
+}
+
+// FieldInt returns the integer value of the field key of the chain of err, and whether it is set and an integer.
+//
+// Any integer type is accepted, as well as floats holding an integer, e.g. the numbers of an error
+// recreated by FromStatus or Decode.
+func FieldInt(err error, key string) (int, bool) {
+	v, ok := field(err, key)
+	if !ok {
+		return 0, false
+	}
+
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true //nolint:gosec
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true //nolint:gosec
+	case float32:
+		return int(n), float32(int(n)) == n
+	case float64:
+		return int(n), float64(int(n)) == n
+	default:
+		return 0, false
+	}
+}
+
+// FieldTime returns the time value of the field key of the chain of err, and whether it is set and a time.
+//
+// RFC 3339 strings are accepted as well, e.g. the times of an error recreated by FromStatus or Decode.
+func FieldTime(err error, key string) (time.Time, bool) {
+	v, ok := field(err, key)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		pt, pErr := time.Parse(time.RFC3339Nano, t)
+
+		return pt, pErr == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// field returns the value of the field key of the chain of err, the innermost one when set more than once.
+func field(err error, key string) (interface{}, bool) {
+	kv := Tuples(err)
+
+	for i := len(kv) - len(kv)%2 - 2; i >= 0; i -= 2 {
+		if k, ok := kv[i].(string); ok && k == key {
+			return kv[i+1], true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,131 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	inner := errors.Enrich(errors.New("no rows"), "table", "users", "id", 1)
+	err := errors.WithCode(errors.Enrich(errors.WrapError(inner, errNotFound), "id", 5, "op", "get"), codes.NotFound)
+
+	testCases := []struct {
+		scenario string
+		err      error
+		tuples   []interface{}
+		fields   map[string]interface{}
+	}{
+		{
+			scenario: "nil",
+		},
+		{
+			scenario: "not enriched",
+			err:      errNotFound,
+		},
+		{
+			scenario: "foreign wrapper",
+			err:      fmt.Errorf("get: %w", errors.Enrich(errNotFound, "id", 5)),
+			tuples:   []interface{}{"id", 5},
+			fields:   map[string]interface{}{"id": 5},
+		},
+		{
+			scenario: "chain with cause",
+			err:      err,
+			tuples:   []interface{}{"id", 5, "op", "get", "table", "users", "id", 1},
+			fields:   map[string]interface{}{"id": 1, "op": "get", "table": "users"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.tuples, errors.Tuples(tc.err))
+			assert.Equal(t, tc.fields, errors.Fields(tc.err))
+		})
+	}
+}
+
+func TestField(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	inner := errors.Enrich(errors.New("no rows"), "id", int64(1), "table", "users")
+	err := errors.Enrich(errors.Wrap(inner, "get user"), "id", 5, "at", at, "ratio", 0.5)
+
+	s, ok := errors.FieldString(err, "table")
+	assert.True(t, ok)
+	assert.Equal(t, "users", s)
+
+	_, ok = errors.FieldString(err, "id")
+	assert.False(t, ok)
+
+	// The innermost value wins, like Fields.
+	n, ok := errors.FieldInt(err, "id")
+	assert.True(t, ok)
+	assert.Equal(t, 1, n)
+
+	_, ok = errors.FieldInt(err, "ratio")
+	assert.False(t, ok)
+
+	tm, ok := errors.FieldTime(err, "at")
+	assert.True(t, ok)
+	assert.Equal(t, at, tm)
+
+	f, ok := errors.Field[float64](err, "ratio")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, f, 0)
+
+	_, ok = errors.Field[string](err, "missing")
+	assert.False(t, ok)
+
+	_, ok = errors.Field[string](nil, "table")
+	assert.False(t, ok)
+
+	t.Run("decoded", func(t *testing.T) {
+		t.Parallel()
+
+		dErr := fromStatus(t, toStatus(t, errors.Enrich(errors.New("failed"), "id", 7, "at", at.Format(time.RFC3339)), codes.Internal))
+
+		n, ok := errors.FieldInt(dErr, "id")
+		assert.True(t, ok)
+		assert.Equal(t, 7, n)
+
+		tm, ok := errors.FieldTime(dErr, "at")
+		assert.True(t, ok)
+		assert.True(t, at.Equal(tm))
+	})
+}
+
+func TestDedupTuples(t *testing.T) {
+	t.Parallel()
+
+	inner := errors.Enrich(errors.New("no rows"), "table", "users", "id", 1)
+	err := errors.Enrich(errors.Wrap(inner, "get user"), "id", 5, "op", "get")
+
+	assert.Equal(t, []interface{}{"id", 5, "op", "get", "table", "users", "id", 1}, errors.Tuples(err))
+	assert.Equal(t, []interface{}{"id", 1, "op", "get", "table", "users"}, errors.DedupTuples(err))
+	assert.Equal(t, []interface{}{"id", 5, "op", "get", "table", "users"},
+		errors.DedupTuples(err, errors.WithMergePolicy(errors.MergeOutermost)))
+
+	assert.Equal(t, map[string]interface{}{"id": 1, "op": "get", "table": "users"}, errors.Fields(err))
+	assert.Equal(t, map[string]interface{}{"id": 5, "op": "get", "table": "users"},
+		errors.Fields(err, errors.WithMergePolicy(errors.MergeOutermost)))
+
+	env := errors.NewEnvelope(err, errors.WithMergePolicy(errors.MergeOutermost))
+	assert.Equal(t, map[string]interface{}{"id": 5, "op": "get", "table": "users"}, env.Fields)
+
+	assert.Nil(t, errors.DedupTuples(nil))
+	assert.Nil(t, errors.DedupTuples(errors.New("failed")))
+}
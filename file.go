@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"io/fs"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Keys of the fields attached by WrapFile.
+const (
+	FileOpKey    = "op"
+	FilePathKey  = "path"
+	FileFlagsKey = "flags"
+)
+
+// WrapFile returns err annotated with the file operation op, e.g. "open", and the path it failed on,
+// carrying them as the FileOpKey and FilePathKey fields, followed by keysAndValues, e.g. FileFlagsKey with the
+// flags of os.OpenFile.
+//
+// The message is "op path: err", unless err is a *fs.PathError for the same operation and path, which already
+// reads so. The error is classified with codes.NotFound, codes.AlreadyExists or codes.PermissionDenied when
+// err matches fs.ErrNotExist, fs.ErrExist or fs.ErrPermission, see CodeOf.
+// If err is nil, WrapFile returns nil.
+func WrapFile(err error, op, path string, keysAndValues ...interface{}) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	kv := make([]interface{}, 0, 4+len(keysAndValues))
+	kv = append(kv, FileOpKey, op, FilePathKey, path)
+	kv = append(kv, keysAndValues...)
+
+	wErr := err
+
+	//nolint:errorlint
+	if pe, ok := err.(*fs.PathError); !ok || pe.Op != op || pe.Path != path {
+		wErr = wrap(err, op+" "+path, callers())
+	}
+
+	wErr = Enrich(wErr, kv...)
+
+	if code, ok := fileCode(err); ok {
+		return WithCode(wErr, code)
+	}
+
+	return wErr
+}
+
+// fileCode returns the grpc code classifying the fs error err.
+func fileCode(err error) (codes.Code, bool) {
+	switch {
+	case Is(err, fs.ErrNotExist):
+		return codes.NotFound, true
+	case Is(err, fs.ErrExist):
+		return codes.AlreadyExists, true
+	case Is(err, fs.ErrPermission):
+		return codes.PermissionDenied, true
+	default:
+		return codes.Unknown, false
+	}
+}
@@ -0,0 +1,65 @@
+package errors_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWrapFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.WrapFile(nil, "open", "/tmp/file"))
+	})
+
+	t.Run("path error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "missing")
+
+		_, oErr := os.OpenFile(path, os.O_RDONLY, 0) //nolint:gosec
+		err := errors.WrapFile(oErr, "open", path, errors.FileFlagsKey, os.O_RDONLY)
+
+		require.EqualError(t, err, oErr.Error())
+		require.ErrorIs(t, err, fs.ErrNotExist)
+		assert.Equal(t, codes.NotFound, errors.CodeOf(err))
+		assert.Equal(t, []interface{}{
+			errors.FileOpKey, "open",
+			errors.FilePathKey, path,
+			errors.FileFlagsKey, os.O_RDONLY,
+		}, errors.Tuples(err))
+
+		var pe *fs.PathError
+		require.ErrorAs(t, err, &pe)
+	})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "permission", err: fs.ErrPermission, code: codes.PermissionDenied},
+		{name: "exist", err: fs.ErrExist, code: codes.AlreadyExists},
+		{name: "other", err: errors.New("disk full"), code: codes.Unknown},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := errors.WrapFile(tc.err, "write", "/tmp/file")
+
+			require.EqualError(t, err, "write /tmp/file: "+tc.err.Error())
+			require.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.code, errors.CodeOf(err))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+)
+
+// CompactMaxLength is the maximum length in bytes of the representation returned by Compact.
+const CompactMaxLength = 256
+
+// Compact returns a single-line representation of err, bounded to CompactMaxLength bytes,
+// made of its message, the message of its root cause when it wraps other errors, and its code unless unknown,
+// see CodeOf.
+//
+// It is meant for log systems with line-size limits, the message is truncated first so the root cause is kept.
+func Compact(err error) string {
+	if IsNil(err) {
+		return ""
+	}
+
+	var suffix string
+
+	// Errors are not compared, some types are not comparable, e.g. slices of errors.
+	if Cause(err) != nil || Unwrap(err) != nil {
+		suffix = " | root: " + truncate(singleLine(RootCause(err).Error()), CompactMaxLength/2)
+	}
+
+	if code := CodeOf(err); code != codes.Unknown {
+		suffix += " | code: " + code.String()
+	}
+
+	return truncate(singleLine(err.Error()), CompactMaxLength-len(suffix)) + suffix
+}
+
+// singleLine replaces line breaks and tabs with spaces.
+func singleLine(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\n', '\r', '\t':
+			return ' '
+		default:
+			return r
+		}
+	}, s)
+}
+
+// truncate shortens s to at most n bytes, ending with an ellipsis when truncated.
+func truncate(s string, n int) string {
+	const ellipsis = "..."
+
+	if len(s) <= n {
+		return s
+	}
+
+	if n <= len(ellipsis) {
+		return ellipsis[:n]
+	}
+
+	s = s[:n-len(ellipsis)]
+
+	// Do not cut a multibyte rune.
+	for len(s) > 0 && !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s + ellipsis
+}
+
+// formatError implements fmt.Formatter for the errors of the package.
+//
+// %s and %v print the error message, %q the quoted message and %+v the chain, one error per line
+// followed by its stack trace when captured.
+func formatError(err error, s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			var sb strings.Builder
+
+			writeVerbose(&sb, err)
+
+			_, _ = io.WriteString(s, sb.String())
+
+			return
+		}
+
+		_, _ = io.WriteString(s, err.Error())
+	case 's':
+		_, _ = io.WriteString(s, err.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", err.Error())
+	}
+}
+
+// writeVerbose writes the chain of err, visiting the wrapped error before the cause.
+func writeVerbose(sb *strings.Builder, err error) {
+	if err == nil {
+		return
+	}
+
+	var next []error
+
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *withError:
+		next = []error{e.err, e.cause}
+	case *MultiError:
+		// The joined errors already make up the message, only their chains are written.
+		for _, jErr := range e.Errors {
+			writeVerbose(sb, jErr)
+		}
+
+		return
+	default:
+		uErr := Unwrap(err)
+
+		// Wrappers that do not change the message, e.g. enrichedError, are skipped.
+		if uErr != nil && stackOf(err) == nil && message(err) == message(uErr) {
+			writeVerbose(sb, uErr)
+
+			return
+		}
+
+		next = []error{uErr}
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(err.Error())
+	writeFrames(sb, stackOf(err))
+
+	for _, n := range next {
+		writeVerbose(sb, n)
+	}
+}
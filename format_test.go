@@ -0,0 +1,76 @@
+package errors_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+// multiError is a multi-error of another package, not comparable.
+type multiError []error
+
+func (me multiError) Error() string {
+	msgs := make([]string, len(me))
+	for i, err := range me {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.Empty(t, errors.Compact(nil))
+	})
+
+	t.Run("without chain", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "failed", errors.Compact(errors.New("failed")))
+	})
+
+	t.Run("with root cause", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WrapError(errors.Wrap(errors.New("failed\nbadly"), "oops"), errors.New("sentinel"))
+
+		require.Equal(t, "sentinel: oops: failed badly | root: failed badly", errors.Compact(err))
+	})
+
+	t.Run("with code", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithCode(errors.Wrap(errors.New("no rows"), "get user"), codes.NotFound)
+
+		require.Equal(t, "get user: no rows | root: no rows | code: NotFound", errors.Compact(err))
+	})
+
+	t.Run("not comparable", func(t *testing.T) {
+		t.Parallel()
+
+		err := multiError{errors.New("failed"), errors.New("oops")}
+
+		require.Equal(t, "failed; oops", errors.Compact(err))
+		require.Equal(t, "get: failed; oops | root: failed; oops", errors.Compact(errors.Wrap(err, "get")))
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.Wrap(errors.New("failed"), strings.Repeat("é", errors.CompactMaxLength))
+
+		compact := errors.Compact(err)
+		require.LessOrEqual(t, len(compact), errors.CompactMaxLength)
+		require.True(t, strings.HasSuffix(compact, "... | root: failed"), compact)
+		require.True(t, utf8.ValidString(compact))
+	})
+}
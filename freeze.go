@@ -0,0 +1,53 @@
+package errors
+
+import "fmt"
+
+type frozenError struct {
+	err error
+}
+
+// Error implements the standard library error interface.
+func (fe *frozenError) Error() string {
+	return fe.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (fe *frozenError) Unwrap() error {
+	return fe.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (fe *frozenError) Format(st fmt.State, verb rune) {
+	formatError(fe, st, verb)
+}
+
+// Freeze returns err marked as immutable, for errors stored in long-lived caches.
+//
+// The lazily computed state of the chain, e.g. the Tuples and Fields of enriched errors, is computed upfront
+// so reading a frozen error never writes to it. Enrich and the wrapping functions always allocate
+// a new outer layer, they never touch the frozen chain.
+// If err is nil, Freeze returns nil.
+func Freeze(err error) error {
+	if IsNil(err) || IsFrozen(err) {
+		return err
+	}
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if ee, ok := err.(*enrichedError); ok {
+			ee.Tuples()
+			ee.Fields()
+		}
+
+		return true
+	})
+
+	return &frozenError{err: err}
+}
+
+// IsFrozen reports whether err was frozen, see Freeze.
+func IsFrozen(err error) bool {
+	var fe *frozenError
+
+	return As(err, &fe)
+}
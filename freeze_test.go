@@ -0,0 +1,46 @@
+package errors_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestFreeze(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, errors.Freeze(nil))
+
+	errFailed := errors.New("failed")
+	require.False(t, errors.IsFrozen(errFailed))
+
+	err := errors.Freeze(errors.EnrichWrapError(errors.Enrich(errFailed, "id", 5), errors.New("oops"), "name", "foo"))
+	require.True(t, errors.IsFrozen(err))
+	require.Equal(t, err, errors.Freeze(err))
+	require.EqualError(t, err, "oops: failed")
+	require.ErrorIs(t, err, errFailed)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errEnriched := errors.Enrich(err, "request", i)
+
+			errKV, ok := errEnriched.(enrichedError)
+			assert.True(t, ok, "error does not implement enrichedError interface")
+			assert.Equal(t, []interface{}{"request", i, "name", "foo", "id", 5}, errKV.Tuples())
+		}(i)
+	}
+
+	wg.Wait()
+
+	require.False(t, errors.IsFrozen(errors.Clone(err)))
+}
@@ -0,0 +1,57 @@
+//go:build errors_minimal
+
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+// The grpc and http integrations are excluded by the errors_minimal build tag, the subtests using them are skipped.
+
+const skipMinimal = "the grpc and http integrations are excluded by the errors_minimal build tag"
+
+// codecs are the names of the registered codecs, the json and proto codecs are excluded
+// by the errors_minimal build tag.
+var codecs []string
+
+func toStatus(t *testing.T, _ error, _ codes.Code, _ ...errors.Option) *status.Status {
+	t.Helper()
+	t.Skip(skipMinimal)
+
+	return nil
+}
+
+func fromStatus(t *testing.T, _ *status.Status) error {
+	t.Helper()
+	t.Skip(skipMinimal)
+
+	return nil
+}
+
+func unaryServerInterceptor(t *testing.T, _ ...errors.ServerOption) grpc.UnaryServerInterceptor {
+	t.Helper()
+	t.Skip(skipMinimal)
+
+	return nil
+}
+
+func unaryClientInterceptor(t *testing.T) grpc.UnaryClientInterceptor {
+	t.Helper()
+	t.Skip(skipMinimal)
+
+	return nil
+}
+
+func handler(t *testing.T, _ func(http.ResponseWriter, *http.Request) error, _ ...errors.ServerOption) http.Handler {
+	t.Helper()
+	t.Skip(skipMinimal)
+
+	return nil
+}
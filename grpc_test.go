@@ -0,0 +1,50 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+// The helpers below wrap the grpc and http integrations, excluded by the errors_minimal build tag, so the tests
+// of the other features still run in the minimal mode, skipping their grpc and http subtests.
+
+// codecs are the names of the registered codecs.
+var codecs = []string{"json", "proto"}
+
+func toStatus(t *testing.T, err error, code codes.Code, opts ...errors.Option) *status.Status {
+	t.Helper()
+
+	return errors.ToStatus(err, code, opts...)
+}
+
+func fromStatus(t *testing.T, st *status.Status) error {
+	t.Helper()
+
+	return errors.FromStatus(st)
+}
+
+func unaryServerInterceptor(t *testing.T, opts ...errors.ServerOption) grpc.UnaryServerInterceptor {
+	t.Helper()
+
+	return errors.UnaryServerInterceptor(opts...)
+}
+
+func unaryClientInterceptor(t *testing.T) grpc.UnaryClientInterceptor {
+	t.Helper()
+
+	return errors.UnaryClientInterceptor()
+}
+
+func handler(t *testing.T, h func(http.ResponseWriter, *http.Request) error, opts ...errors.ServerOption) http.Handler {
+	t.Helper()
+
+	return errors.Handler(h, opts...)
+}
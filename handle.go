@@ -0,0 +1,72 @@
+package errors
+
+// checked carries the error given to Check up to Handle.
+type checked struct {
+	err error
+}
+
+// Check panics with err if it is not nil, the panic is recovered by Handle.
+//
+// Experimental: Check must only be called from functions that defer Handle, it is meant
+// for code-generation-heavy layers where the if err != nil boilerplate dominates.
+//
+//	func do() (err error) {
+//		defer errors.Handle(&err, "doing X")
+//
+//		errors.Check(step())
+//
+//		return nil
+//	}
+func Check(err error) {
+	if !IsNil(err) {
+		panic(checked{err: err})
+	}
+}
+
+// Handle recovers the error given to Check and stores it in errp annotated with message.
+// An error already returned through errp is annotated the same way.
+//
+// Handle must be deferred directly, panics not raised by Check are propagated.
+//
+// Experimental: see Check.
+func Handle(errp *error, message string) {
+	if r := recover(); r != nil {
+		c, ok := r.(checked)
+		if !ok {
+			panic(r)
+		}
+
+		*errp = c.err
+	}
+
+	*errp = Wrap(*errp, message)
+}
+
+// Try returns v, calling Check with err first.
+//
+//	f := errors.Try(os.Open(name))
+//
+// Experimental: see Check.
+func Try[T any](v T, err error) T {
+	Check(err)
+
+	return v
+}
+
+// Try2 returns v1 and v2, calling Check with err first.
+//
+// Experimental: see Check.
+func Try2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	Check(err)
+
+	return v1, v2
+}
+
+// Try3 returns v1, v2 and v3, calling Check with err first.
+//
+// Experimental: see Check.
+func Try3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	Check(err)
+
+	return v1, v2, v3
+}
@@ -0,0 +1,103 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	run := func(checkErr, returnErr error) (err error) {
+		defer errors.Handle(&err, "doing X")
+
+		errors.Check(checkErr)
+
+		return returnErr
+	}
+
+	t.Run("Check with error", func(t *testing.T) {
+		t.Parallel()
+
+		err := run(errFailed, nil)
+		require.EqualError(t, err, "doing X: failed")
+		require.ErrorIs(t, err, errFailed)
+	})
+
+	t.Run("Check with nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, run(nil, nil))
+	})
+
+	t.Run("returned error", func(t *testing.T) {
+		t.Parallel()
+
+		err := run(nil, errFailed)
+		require.EqualError(t, err, "doing X: failed")
+		require.ErrorIs(t, err, errFailed)
+	})
+
+	t.Run("foreign panic", func(t *testing.T) {
+		t.Parallel()
+
+		require.PanicsWithValue(t, "boom", func() {
+			var err error
+
+			defer errors.Handle(&err, "doing X")
+
+			panic("boom")
+		})
+	})
+}
+
+func TestTry(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	one := func(err error) (int, error) {
+		return 1, err
+	}
+
+	two := func(err error) (int, string, error) {
+		return 1, "two", err
+	}
+
+	three := func(err error) (int, string, bool, error) {
+		return 1, "two", true, err
+	}
+
+	run := func(fnErr error) (sum string, err error) {
+		defer errors.Handle(&err, "doing X")
+
+		i := errors.Try(one(fnErr))
+		j, s := errors.Try2(two(fnErr))
+		k, s2, b := errors.Try3(three(fnErr))
+
+		return fmt.Sprintln(i, j, s, k, s2, b), nil
+	}
+
+	t.Run("without error", func(t *testing.T) {
+		t.Parallel()
+
+		sum, err := run(nil)
+		require.NoError(t, err)
+		require.Equal(t, "1 1 two 1 two true\n", sum)
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		t.Parallel()
+
+		sum, err := run(errFailed)
+		require.EqualError(t, err, "doing X: failed")
+		require.ErrorIs(t, err, errFailed)
+		require.Empty(t, sum)
+	})
+}
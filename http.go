@@ -0,0 +1,180 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// HandlerFunc is an HTTP handler returning an error, the error is written as a problem details response
+// unless the handler already wrote the response header.
+//
+// Panics of the handler are recovered and written as an http.StatusInternalServerError response,
+// the response is aborted with http.ErrAbortHandler if the handler already wrote the response header.
+// The message of errors resolved to a 5xx status is internal, it is redacted from the response.
+// The fields of the errors are not exposed, see WithProblemFields.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP implements http.Handler.
+func (h HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	Handler(h).ServeHTTP(w, r)
+}
+
+// Handler returns an http.Handler serving h configured with opts, see HandlerFunc.
+// The errors are enriched with the key-value pairs attached to the request context, see ContextWith.
+func Handler(h HandlerFunc, opts ...ServerOption) http.Handler {
+	o := newServerOptions(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(o.baggageKeys) > 0 {
+			r = r.WithContext(o.baggageContext(r.Context(), r.Header.Values(BaggageHeader)))
+		}
+
+		rw := &responseWriter{ResponseWriter: w}
+
+		defer o.recoverHTTP(rw, r)
+
+		if err := h(rw, r); !IsNil(err) {
+			o.writeError(r.Context(), rw, EnrichCtx(r.Context(), err))
+		}
+	})
+}
+
+// HTTPMiddleware returns an http.Handler recovering the panics of next and writing them
+// as a redacted http.StatusInternalServerError problem details response. If next already wrote
+// the response header, the response is aborted with http.ErrAbortHandler instead, so the client
+// does not take the truncated response for a successful one.
+func HTTPMiddleware(next http.Handler, opts ...ServerOption) http.Handler {
+	o := newServerOptions(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(o.baggageKeys) > 0 {
+			r = r.WithContext(o.baggageContext(r.Context(), r.Header.Values(BaggageHeader)))
+		}
+
+		rw := &responseWriter{ResponseWriter: w}
+
+		defer o.recoverHTTP(rw, r)
+
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// responseWriter records whether the response header was written, so errors are only written to
+// responses not started yet.
+type responseWriter struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.wroteHeader = true
+
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+
+	return rw.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher.
+func (rw *responseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		rw.wroteHeader = true
+
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped http.ResponseWriter, see http.ResponseController.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// recoverHTTP writes a recovered panic as a problem details response.
+// http.ErrAbortHandler is propagated to abort the response, and raised if the response header was already written.
+func (o *serverOptions) recoverHTTP(w *responseWriter, req *http.Request) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	//nolint:errorlint,err113
+	if r == http.ErrAbortHandler {
+		panic(r)
+	}
+
+	if w.wroteHeader {
+		panic(http.ErrAbortHandler)
+	}
+
+	// Skip runtime.Callers, captureStack and recoverHTTP.
+	o.writeError(req.Context(), w, WithHTTPStatus(recoverPanic(r, 3), http.StatusInternalServerError))
+}
+
+// writeError writes err as a problem details response, redacting server errors.
+// Nothing is written if the response header was already written.
+func (o *serverOptions) writeError(ctx context.Context, w *responseWriter, err error) {
+	if w.wroteHeader {
+		return
+	}
+
+	env := o.envelope(ctx, err)
+	if env == nil {
+		env = &Envelope{HTTPStatus: HTTPStatusOf(err)}
+		env.redact()
+	}
+
+	if env.HTTPStatus >= http.StatusInternalServerError {
+		env.redact()
+	} else if !o.problemFields {
+		env.Fields = nil
+	}
+
+	env.writeRetryAfter(w)
+	writeProblem(w, env.problem())
+}
+
+// writeRetryAfter sets the Retry-After header when the envelope has a retry delay.
+func (e *Envelope) writeRetryAfter(w http.ResponseWriter) {
+	if e == nil || e.RetryAfter <= 0 {
+		return
+	}
+
+	// Retry-After is in whole seconds, rounded up so clients never retry too early.
+	w.Header().Set("Retry-After", strconv.FormatInt(int64((e.RetryAfter+time.Second-1)/time.Second), 10))
+}
+
+// redact removes the internal message, fields and chain from the envelope,
+// the message is replaced by the text of the HTTP status.
+func (e *Envelope) redact() {
+	e.Message = http.StatusText(e.HTTPStatus)
+	e.Fields = nil
+	e.Chain = nil
+	e.StackEntries = nil
+	e.Panic = nil
+	e.Ops = nil
+}
+
+// writeProblem writes p as an application/problem+json response.
+func writeProblem(w http.ResponseWriter, p Problem) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		// Extensions that can not be marshaled are dropped rather than failing the response.
+		p.Extensions = nil
+		b, _ = json.Marshal(p) //nolint:errchkjson
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, _ = w.Write(b)
+}
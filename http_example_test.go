@@ -0,0 +1,31 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func ExampleHandler() {
+	h := errors.Handler(func(http.ResponseWriter, *http.Request) error {
+		return errors.WithCode(errors.Enrich(errors.New("user not found"), "id", 5), codes.NotFound)
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/5", nil))
+
+	fmt.Println(rec.Code)
+	fmt.Println(rec.Header().Get("Content-Type"))
+	fmt.Println(rec.Body.String())
+
+	// Output:
+	// 404
+	// application/problem+json
+	// {"detail":"user not found","status":404,"title":"Not Found","type":"about:blank"}
+}
@@ -0,0 +1,164 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	serve := func(h errors.HandlerFunc) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		return rec
+	}
+
+	t.Run("without error", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(w http.ResponseWriter, _ *http.Request) error {
+			w.WriteHeader(http.StatusNoContent)
+
+			return nil
+		})
+
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(http.ResponseWriter, *http.Request) error {
+			return errors.WithCode(errors.Enrich(errors.New("user not found"), "id", 5), codes.NotFound)
+		})
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Equal(t, errors.ProblemContentType, rec.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"user not found"}`,
+			rec.Body.String(), "the fields are not exposed")
+	})
+
+	t.Run("client error with fields", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+
+		errors.Handler(func(http.ResponseWriter, *http.Request) error {
+			return errors.WithCode(errors.Enrich(errors.New("user not found"), "id", 5), codes.NotFound)
+		}, errors.WithProblemFields()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"user not found","id":5}`,
+			rec.Body.String())
+	})
+
+	t.Run("typed nil error", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(http.ResponseWriter, *http.Request) error {
+			var err *nilError
+
+			return err
+		})
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, rec.Body.String())
+	})
+
+	t.Run("error after the header", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(w http.ResponseWriter, _ *http.Request) error {
+			w.WriteHeader(http.StatusAccepted)
+
+			return errors.New("failed")
+		})
+
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		require.Empty(t, rec.Body.String())
+	})
+
+	t.Run("server error redacted", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(http.ResponseWriter, *http.Request) error {
+			return errors.Enrich(errors.New("connection to 10.0.0.1 refused"), "dsn", "postgres://")
+		})
+
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+		require.JSONEq(t,
+			`{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Internal Server Error"}`,
+			rec.Body.String())
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(func(http.ResponseWriter, *http.Request) error {
+			panic("boom")
+		})
+
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+		require.JSONEq(t,
+			`{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Internal Server Error"}`,
+			rec.Body.String())
+	})
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	t.Parallel()
+
+	h := errors.HTTPMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, errors.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	t.Run("panic after the header", func(t *testing.T) {
+		t.Parallel()
+
+		h := errors.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("partial"))
+
+			panic("boom")
+		}))
+
+		require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		})
+
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		res, err := http.Get(srv.URL)
+		if err == nil {
+			_, err = io.ReadAll(res.Body)
+			_ = res.Body.Close()
+		}
+
+		require.Error(t, err, "the response is aborted")
+	})
+
+	require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		errors.HTTPMiddleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic(http.ErrAbortHandler)
+		})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
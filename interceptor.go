@@ -0,0 +1,234 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor returns a grpc.UnaryServerInterceptor converting the errors returned by handlers
+// into a status with the error chain in its details, see ToStatus.
+// The errors are enriched with the key-value pairs attached to the request context, see ContextWith.
+//
+// The status code is resolved with CodeOf.
+// Panics of the handler are recovered and returned as a codes.Internal status, see Recover.
+func UnaryServerInterceptor(opts ...ServerOption) grpc.UnaryServerInterceptor {
+	o := newServerOptions(opts)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		ctx = o.incomingBaggageContext(ctx)
+
+		defer o.recoverGRPC(ctx, &err)
+
+		resp, err = handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		return resp, o.statusError(ctx, err)
+	}
+}
+
+// StreamServerInterceptor returns a grpc.StreamServerInterceptor converting the errors returned by stream handlers,
+// including the ones produced mid-stream, into a status with the error chain in its details,
+// see UnaryServerInterceptor.
+func StreamServerInterceptor(opts ...ServerOption) grpc.StreamServerInterceptor {
+	o := newServerOptions(opts)
+
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := context.Background()
+		if ss != nil {
+			ctx = ss.Context()
+
+			if bctx := o.incomingBaggageContext(ctx); bctx != ctx {
+				ctx = bctx
+				ss = &serverStream{ServerStream: ss, ctx: ctx}
+			}
+		}
+
+		defer o.recoverGRPC(ctx, &err)
+
+		err = handler(srv, ss)
+		if err == nil {
+			return nil
+		}
+
+		return o.statusError(ctx, err)
+	}
+}
+
+// recoverGRPC stores a recovered panic in errp as a codes.Internal status error.
+func (o *serverOptions) recoverGRPC(ctx context.Context, errp *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	// Skip runtime.Callers, captureStack and recoverGRPC.
+	*errp = o.statusError(ctx, WithCode(recoverPanic(r, 3), codes.Internal))
+}
+
+// statusError converts err into a status error for the client of ctx.
+func (o *serverOptions) statusError(ctx context.Context, err error) error {
+	if o.passThroughStatus {
+		//nolint:errorlint
+		if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			return err
+		}
+	}
+
+	env := o.envelope(ctx, EnrichCtx(ctx, err))
+	if env == nil {
+		// The details are dropped, the message too as it may be internal.
+		code := CodeOf(err)
+
+		return status.New(code, code.String()).Err()
+	}
+
+	if o.negotiate && !HasCapability(ctx, CapabilityChain) {
+		// Clients not understanding the chain only get the message and the standard details.
+		env.Chain = nil
+	}
+
+	return env.status().Err()
+}
+
+// incomingBaggageContext returns ctx with the baggage entries of the incoming grpc metadata selected
+// by WithBaggageFields attached.
+func (o *serverOptions) incomingBaggageContext(ctx context.Context) context.Context {
+	if len(o.baggageKeys) == 0 {
+		return ctx
+	}
+
+	return o.baggageContext(ctx, metadata.ValueFromIncomingContext(ctx, BaggageHeader))
+}
+
+// serverStream is a grpc.ServerStream with a custom context.
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+// Context implements grpc.ServerStream.
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+// UnaryClientInterceptor returns a grpc.UnaryClientInterceptor recreating the error chain from the status
+// returned by the server, see FromStatus.
+//
+// The recreated error keeps the status, so status.FromError and status.Code still work on it.
+// Errors whose status does not carry an error chain are returned untouched.
+// The capabilities of the client are advertised to the server in the request metadata, see NegotiateCapabilities.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return fromStatusError(invoker(advertiseCapabilities(ctx), method, req, reply, cc, opts...))
+	}
+}
+
+// StreamClientInterceptor returns a grpc.StreamClientInterceptor recreating the error chain from the status
+// returned by the server, when the stream is created and by the stream methods, see UnaryClientInterceptor.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		cs, err := streamer(advertiseCapabilities(ctx), desc, cc, method, opts...)
+		if err != nil {
+			return nil, fromStatusError(err)
+		}
+
+		return &clientStream{ClientStream: cs}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+}
+
+// SendMsg implements grpc.ClientStream, recreating the error chain from the status.
+func (cs *clientStream) SendMsg(m interface{}) error {
+	return fromStatusError(cs.ClientStream.SendMsg(m))
+}
+
+// RecvMsg implements grpc.ClientStream, recreating the error chain from the status.
+func (cs *clientStream) RecvMsg(m interface{}) error {
+	return fromStatusError(cs.ClientStream.RecvMsg(m))
+}
+
+// statusError is an error recreated from a status, keeping the status.
+type statusError struct {
+	err error
+	st  *status.Status
+}
+
+// Error implements the standard library error interface.
+func (se *statusError) Error() string {
+	return se.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (se *statusError) Unwrap() error {
+	return se.err
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (se *statusError) LogValue() slog.Value {
+	return logValue(se)
+}
+
+// GRPCStatus returns the status the error was recreated from, used by status.FromError.
+func (se *statusError) GRPCStatus() *status.Status {
+	return se.st
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (se *statusError) Format(st fmt.State, verb rune) {
+	formatError(se, st, verb)
+}
+
+// clone implements cloner.
+func (se *statusError) clone() error {
+	return &statusError{err: Clone(se.err), st: se.st}
+}
+
+// fromStatusError recreates the error chain from the status of err, if it carries one.
+func fromStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok || !hasChain(st) {
+		return err
+	}
+
+	return &statusError{
+		err: FromStatus(st),
+		st:  st,
+	}
+}
@@ -0,0 +1,326 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := errors.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	call := func(err error) (interface{}, error) {
+		return interceptor(context.Background(), "req", info, func(context.Context, interface{}) (interface{}, error) {
+			return "resp", err
+		})
+	}
+
+	t.Run("without error", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := call(nil)
+		require.NoError(t, err)
+		require.Equal(t, "resp", resp)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := interceptor(context.Background(), "req", info, func(context.Context, interface{}) (interface{}, error) {
+			panic("boom")
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, codes.Internal, st.Code())
+		require.Equal(t, "panic: boom", st.Message())
+		require.ErrorIs(t, errors.FromStatus(st), errors.ErrPanic)
+	})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "package error", err: errors.Enrich(errors.New("failed"), "id", 5), code: codes.Unknown},
+		{name: "canceled", err: errors.Wrap(context.Canceled, "oops"), code: codes.Canceled},
+		{name: "deadline exceeded", err: errors.Wrap(context.DeadlineExceeded, "oops"), code: codes.DeadlineExceeded},
+		{name: "status error", err: status.Error(codes.NotFound, "failed"), code: codes.NotFound},
+		{name: "error with code", err: errors.WithCode(errors.New("failed"), codes.NotFound), code: codes.NotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := call(tc.err)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok, "error is not a status error")
+			require.Equal(t, tc.code, st.Code())
+			require.Equal(t, tc.err.Error(), st.Message())
+			require.EqualError(t, errors.FromStatus(st), tc.err.Error())
+		})
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := errors.UnaryClientInterceptor()
+
+	call := func(err error) error {
+		return interceptor(context.Background(), "/test.Service/Method", "req", "reply", nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				return err
+			},
+		)
+	}
+
+	t.Run("without error", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, call(nil))
+	})
+
+	t.Run("status without chain", func(t *testing.T) {
+		t.Parallel()
+
+		sErr := status.Error(codes.NotFound, "failed")
+
+		require.Equal(t, sErr, call(sErr))
+	})
+
+	t.Run("status with chain", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := errors.New("not found")
+
+		err := call(errors.ToStatus(errors.EnrichWrapError(errors.New("no rows"), errNotFound, "id", 5), codes.NotFound).Err())
+		require.EqualError(t, err, "not found: no rows")
+		require.ErrorIs(t, err, errNotFound)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+type clientStream struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (cs *clientStream) SendMsg(interface{}) error {
+	return cs.err
+}
+
+func (cs *clientStream) RecvMsg(interface{}) error {
+	return cs.err
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := errors.StreamClientInterceptor()
+	errNotFound := errors.New("not found")
+	sErr := errors.ToStatus(errors.WrapError(errors.New("no rows"), errNotFound), codes.NotFound).Err()
+
+	stream := func(streamErr, msgErr error) (grpc.ClientStream, error) {
+		return interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/test.Service/Method",
+			func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+				if streamErr != nil {
+					return nil, streamErr
+				}
+
+				return &clientStream{err: msgErr}, nil
+			},
+		)
+	}
+
+	t.Run("stream creation error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := stream(sErr, nil)
+		require.ErrorIs(t, err, errNotFound)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("message errors", func(t *testing.T) {
+		t.Parallel()
+
+		cs, err := stream(nil, sErr)
+		require.NoError(t, err)
+
+		for _, err := range []error{cs.SendMsg("req"), cs.RecvMsg("resp")} {
+			require.EqualError(t, err, "not found: no rows")
+			require.ErrorIs(t, err, errNotFound)
+			require.Equal(t, codes.NotFound, status.Code(err))
+		}
+	})
+
+	t.Run("end of stream", func(t *testing.T) {
+		t.Parallel()
+
+		cs, err := stream(nil, io.EOF)
+		require.NoError(t, err)
+		require.Equal(t, io.EOF, cs.RecvMsg("resp"))
+	})
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := errors.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream", IsServerStream: true}
+
+	call := func(err error) error {
+		return interceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error {
+			return err
+		})
+	}
+
+	t.Run("without error", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, call(nil))
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		err := interceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error {
+			panic("boom")
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, codes.Internal, st.Code())
+		require.ErrorIs(t, errors.FromStatus(st), errors.ErrPanic)
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		t.Parallel()
+
+		errSend := errors.New("send failed")
+
+		err := call(errors.Enrich(errors.Wrap(errSend, "stream prices"), "sent", 3))
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, codes.Unknown, st.Code())
+
+		cErr := errors.FromStatus(st)
+		require.EqualError(t, cErr, "stream prices: send failed")
+		require.ErrorIs(t, cErr, errSend)
+	})
+}
+
+func TestPassThroughStatusErrors(t *testing.T) {
+	t.Parallel()
+
+	interceptor := errors.UnaryServerInterceptor(errors.PassThroughStatusErrors())
+
+	call := func(err error) error {
+		_, err = interceptor(context.Background(), "req", nil, func(context.Context, interface{}) (interface{}, error) {
+			return nil, err
+		})
+
+		return err
+	}
+
+	t.Run("status error", func(t *testing.T) {
+		t.Parallel()
+
+		sErr := status.Error(codes.NotFound, "not found")
+
+		require.Same(t, sErr, call(sErr))
+	})
+
+	t.Run("wrapped status error", func(t *testing.T) {
+		t.Parallel()
+
+		err := call(errors.Wrap(status.Error(codes.NotFound, "not found"), "upstream"))
+
+		st, ok := status.FromError(err)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "upstream: rpc error: code = NotFound desc = not found", st.Message())
+	})
+
+	t.Run("package error", func(t *testing.T) {
+		t.Parallel()
+
+		err := call(errors.New("failed"))
+		require.EqualError(t, errors.FromStatus(status.Convert(err)), "failed")
+	})
+}
+
+func TestNegotiateCapabilities(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	hErr := errors.WithReason(errors.WithCode(errors.WrapError(errors.New("no rows"), errNotFound), codes.NotFound),
+		"users.example.com", "USER_NOT_FOUND")
+
+	serve := func(ctx context.Context, opts ...errors.ServerOption) error {
+		_, err := errors.UnaryServerInterceptor(opts...)(ctx, "req", &grpc.UnaryServerInfo{},
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, hErr
+			})
+
+		return err
+	}
+
+	// The client interceptor advertises its capabilities in the outgoing metadata.
+	var clientCtx context.Context
+
+	err := errors.UnaryClientInterceptor()(context.Background(), "/test.Service/Method", "req", "reply", nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			clientCtx = metadata.NewIncomingContext(ctx, md)
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, errors.HasCapability(clientCtx, errors.CapabilityChain))
+
+	t.Run("capable client", func(t *testing.T) {
+		t.Parallel()
+
+		err := serve(clientCtx, errors.NegotiateCapabilities())
+		require.ErrorIs(t, errors.FromStatus(status.Convert(err)), errNotFound)
+	})
+
+	t.Run("legacy client", func(t *testing.T) {
+		t.Parallel()
+
+		err := serve(context.Background(), errors.NegotiateCapabilities())
+		require.False(t, errors.HasCapability(context.Background(), errors.CapabilityChain))
+
+		st := status.Convert(err)
+		require.Equal(t, "not found: no rows", st.Message())
+		require.NotErrorIs(t, errors.FromStatus(st), errNotFound, "the chain is not sent")
+
+		_, reason, ok := errors.ReasonOf(err)
+		require.True(t, ok, "standard details are sent")
+		require.Equal(t, "USER_NOT_FOUND", reason)
+	})
+
+	t.Run("without negotiation", func(t *testing.T) {
+		t.Parallel()
+
+		err := serve(context.Background())
+		require.ErrorIs(t, errors.FromStatus(status.Convert(err)), errNotFound)
+	})
+}
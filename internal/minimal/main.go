@@ -0,0 +1,20 @@
+// Command minimal exercises the core API built with the errors_minimal tag, it is compiled with TinyGo
+// by the build-tinygo make target to keep the minimal mode buildable for constrained targets.
+package main
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+var errNotFound = errors.NewSentinel("minimal.NotFound", "not found")
+
+func main() {
+	err := errors.WithCode(errors.Enrich(errors.WrapError(errors.New("no rows"), errNotFound), "id", 5), codes.NotFound)
+	err = errors.Wrap(errors.Join(err, nil), "get user")
+
+	fmt.Println(err, errors.Is(err, errNotFound), errors.CodeOf(err), errors.KindOf(err), errors.Fields(err))
+}
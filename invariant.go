@@ -0,0 +1,50 @@
+package errors
+
+import "fmt"
+
+// ErrInvariant is the error matched by the errors returned by Invariant and InvariantErr.
+var ErrInvariant = NewSentinel("errors.Invariant", "invariant violation")
+
+// Severity key and values of the errors enriched with their severity, e.g. by Invariant.
+const (
+	SeverityKey      = "severity"
+	SeverityCritical = "critical"
+)
+
+// Invariant returns nil when cond holds, otherwise an error reporting the violation of an internal invariant
+// with the formats according to a format specifier.
+//
+// The error matches ErrInvariant, is enriched with the critical severity and always carries the stack trace
+// at the point Invariant is called, even when stack trace capture is disabled, see EnableStackTrace.
+//
+//	if err := errors.Invariant(len(ids) == len(rows), "%d ids for %d rows", len(ids), len(rows)); err != nil {
+//		return err
+//	}
+func Invariant(cond bool, format string, args ...any) error {
+	if cond {
+		return nil
+	}
+
+	// Skip runtime.Callers, captureStack and Invariant.
+	return invariant(&errorString{message: fmt.Sprintf(format, args...)}, captureStack(3))
+}
+
+// InvariantErr returns nil when cond holds, otherwise an error reporting the violation of an internal invariant
+// wrapping sentinel, see Invariant.
+func InvariantErr(cond bool, sentinel error) error {
+	if cond {
+		return nil
+	}
+
+	if IsNil(sentinel) {
+		sentinel = ErrInvariant
+	}
+
+	// Skip runtime.Callers, captureStack and InvariantErr.
+	return invariant(sentinel, captureStack(3))
+}
+
+// invariant returns the invariant violation of cause with the stack trace.
+func invariant(cause error, stack stack) error {
+	return Enrich(wrapError(cause, ErrInvariant, stack), SeverityKey, SeverityCritical)
+}
@@ -0,0 +1,45 @@
+package errors_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestInvariant(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, errors.Invariant(true, "unreachable"))
+
+	err := errors.Invariant(false, "%d ids for %d rows", 2, 3)
+	require.EqualError(t, err, "invariant violation: 2 ids for 3 rows")
+	require.ErrorIs(t, err, errors.ErrInvariant)
+	require.NotErrorIs(t, errors.New("invariant violation"), errors.ErrInvariant, "only the sentinel matches")
+	assert.Equal(t, map[string]interface{}{errors.SeverityKey: errors.SeverityCritical}, errors.Fields(err))
+
+	frames := errors.StackTrace(err)
+	require.NotEmpty(t, frames, "stack trace is always captured")
+	assert.True(t, strings.HasPrefix(frames[0].Function, "github.com/dohernandez/errors_test.TestInvariant"), frames[0].Function)
+}
+
+func TestInvariantErr(t *testing.T) {
+	t.Parallel()
+
+	errCorrupted := errors.New("corrupted index")
+
+	require.NoError(t, errors.InvariantErr(true, errCorrupted))
+
+	err := errors.InvariantErr(false, errCorrupted)
+	require.EqualError(t, err, "invariant violation: corrupted index")
+	require.ErrorIs(t, err, errors.ErrInvariant)
+	require.ErrorIs(t, err, errCorrupted)
+	assert.Equal(t, map[string]interface{}{errors.SeverityKey: errors.SeverityCritical}, errors.Fields(err))
+
+	frames := errors.StackTrace(err)
+	require.NotEmpty(t, frames, "stack trace is always captured")
+	assert.True(t, strings.HasPrefix(frames[0].Function, "github.com/dohernandez/errors_test.TestInvariantErr"), frames[0].Function)
+}
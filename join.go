@@ -0,0 +1,143 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError is an error made of several errors, see Join and Append.
+//
+// It is a drop-in replacement for the *multierror.Error of github.com/hashicorp/go-multierror,
+// the usual patterns keep working:
+//
+//	var result *errors.MultiError
+//
+//	for _, item := range items {
+//		if err := process(item); err != nil {
+//			result = errors.Append(result, err)
+//		}
+//	}
+//
+//	return result.ErrorOrNil()
+//
+// as well as reading the Errors field, WrappedErrors and Len. Custom message formats (ErrorFormat),
+// Flatten, Prefix and Group are not supported.
+type MultiError struct {
+	Errors []error
+}
+
+// Join returns an error wrapping the supplied errors, nil errors are discarded.
+// If all errors are nil, Join returns nil, else a *MultiError.
+//
+// The message is the messages of the errors separated by newlines. Is and As match any of the errors,
+// and the key-value pairs of all of them are available through Fields and Tuples.
+func Join(errs ...error) error {
+	var joined []error
+
+	for _, err := range errs {
+		if !IsNil(err) {
+			joined = append(joined, err)
+		}
+	}
+
+	if len(joined) == 0 {
+		return nil
+	}
+
+	return &MultiError{Errors: joined}
+}
+
+// Append appends errs to err, as github.com/hashicorp/go-multierror does, nil errors are discarded
+// and the errors of a *MultiError are appended instead of it.
+//
+// If err is a *MultiError, possibly nil, errs are appended to it and it is returned,
+// else a new *MultiError made of err and errs is returned.
+// Use ErrorOrNil to return the result as an error.
+func Append(err error, errs ...error) *MultiError {
+	me, ok := err.(*MultiError) //nolint:errorlint
+	if !ok {
+		me = &MultiError{}
+		errs = append([]error{err}, errs...)
+	} else if me == nil {
+		me = &MultiError{}
+	}
+
+	for _, e := range errs {
+		if IsNil(e) {
+			continue
+		}
+
+		//nolint:errorlint
+		if eme, ok := e.(*MultiError); ok {
+			me.Errors = append(me.Errors, eme.Errors...)
+		} else {
+			me.Errors = append(me.Errors, e)
+		}
+	}
+
+	return me
+}
+
+// Error implements the standard library error interface.
+func (me *MultiError) Error() string {
+	var sb strings.Builder
+
+	for i, err := range me.Errors {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString(err.Error())
+	}
+
+	return sb.String()
+}
+
+// Unwrap returns the joined errors, it is used by errors.Is and errors.As.
+func (me *MultiError) Unwrap() []error {
+	return me.Errors
+}
+
+// WrappedErrors returns the joined errors, nil if me is nil.
+func (me *MultiError) WrappedErrors() []error {
+	if me == nil {
+		return nil
+	}
+
+	return me.Errors
+}
+
+// Len returns the number of joined errors.
+func (me *MultiError) Len() int {
+	if me == nil {
+		return 0
+	}
+
+	return len(me.Errors)
+}
+
+// ErrorOrNil returns nil if me is nil or holds no errors, me otherwise.
+//
+// Returning the result of ErrorOrNil avoids a non-nil error interface holding an empty collector.
+func (me *MultiError) ErrorOrNil() error {
+	if me.Len() == 0 {
+		return nil
+	}
+
+	return me
+}
+
+// Format implements fmt.Formatter, %+v prints the chain of every joined error with stack traces.
+func (me *MultiError) Format(st fmt.State, verb rune) {
+	formatError(me, st, verb)
+}
+
+// unwrapMulti returns the errors wrapped by err through the Unwrap() []error method, nil if there are none.
+func unwrapMulti(err error) []error {
+	//nolint:errorlint
+	if u, ok := err.(interface{ Unwrap() []error }); ok {
+		return u.Unwrap()
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestAppend(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := errors.New("invalid")
+	errRequired := errors.New("required")
+
+	t.Run("collector", func(t *testing.T) {
+		t.Parallel()
+
+		var result *errors.MultiError
+
+		for _, err := range []error{errInvalid, nil, errRequired} {
+			result = errors.Append(result, err)
+		}
+
+		require.Equal(t, []error{errInvalid, errRequired}, result.WrappedErrors())
+		require.EqualError(t, result.ErrorOrNil(), "invalid\nrequired")
+		require.ErrorIs(t, result.ErrorOrNil(), errRequired)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var result *errors.MultiError
+
+		assert.True(t, result.ErrorOrNil() == nil)
+		assert.Empty(t, result.WrappedErrors())
+
+		result = errors.Append(result, nil)
+		assert.True(t, result.ErrorOrNil() == nil)
+		assert.True(t, (&errors.MultiError{}).ErrorOrNil() == nil)
+
+		collect := func() error {
+			return result.ErrorOrNil()
+		}
+
+		assert.NoError(t, collect())
+	})
+
+	t.Run("plain error", func(t *testing.T) {
+		t.Parallel()
+
+		me := errors.Append(errInvalid, errRequired)
+		require.Equal(t, []error{errInvalid, errRequired}, me.Errors)
+		require.Equal(t, 2, me.Len())
+	})
+
+	t.Run("flattened", func(t *testing.T) {
+		t.Parallel()
+
+		me := errors.Append(errors.Join(errInvalid), errors.Append(nil, errRequired), (*errors.MultiError)(nil))
+		require.Equal(t, []error{errInvalid, errRequired}, me.Errors)
+	})
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := errors.New("invalid")
+	errRequired := errors.New("required")
+
+	err := errors.Join(
+		errors.Enrich(errors.Wrap(errInvalid, "email"), "field", "email"),
+		nil,
+		errors.Enrich(errors.WrapError(errors.New("missing"), errRequired), "name", "empty"),
+	)
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, errors.Join() == nil)
+		assert.True(t, errors.Join(nil, nil) == nil)
+	})
+
+	t.Run("message", func(t *testing.T) {
+		t.Parallel()
+
+		require.EqualError(t, err, "email: invalid\nrequired: missing")
+		assert.Equal(t, "email: invalid\ninvalid\nrequired: missing\nrequired\nmissing", fmt.Sprintf("%+v", err))
+	})
+
+	t.Run("is", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, err, errInvalid)
+		require.ErrorIs(t, err, errRequired)
+		require.ErrorIs(t, fmt.Errorf("validate: %w", err), errRequired)
+	})
+
+	t.Run("multi error", func(t *testing.T) {
+		t.Parallel()
+
+		var me *errors.MultiError
+
+		require.True(t, errors.As(err, &me))
+		require.Equal(t, 2, me.Len())
+		require.Equal(t, me.Errors, me.WrappedErrors())
+		require.ErrorIs(t, me.Errors[0], errInvalid)
+		require.ErrorIs(t, me.Errors[1], errRequired)
+		assert.Equal(t, err, me.ErrorOrNil())
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, map[string]interface{}{"field": "email", "name": "empty"}, errors.Fields(err))
+		assert.Equal(t, map[string]interface{}{"field": "email", "name": "empty"},
+			errors.Fields(errors.Wrap(err, "validate")))
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		t.Parallel()
+
+		cErr := errors.Clone(err)
+		require.EqualError(t, cErr, err.Error())
+		require.ErrorIs(t, cErr, errRequired)
+		assert.Equal(t, errors.Fields(err), errors.Fields(cErr))
+	})
+
+	for _, name := range codecs {
+		t.Run("codec "+name, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(name, errors.Wrap(err, "validate"))
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(name, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+			require.EqualError(t, dErr, "validate: email: invalid\nrequired: missing")
+			require.ErrorIs(t, dErr, errInvalid)
+			require.ErrorIs(t, dErr, errRequired)
+			assert.Equal(t, map[string]interface{}{"field": "email", "name": "empty"}, errors.Fields(dErr))
+		})
+	}
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		dErr := fromStatus(t, toStatus(t, err, codes.InvalidArgument))
+		require.ErrorIs(t, dErr, errInvalid)
+		require.ErrorIs(t, dErr, errRequired)
+		assert.Equal(t, map[string]interface{}{"field": "email", "name": "empty"}, errors.Fields(dErr))
+	})
+}
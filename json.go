@@ -0,0 +1,50 @@
+//go:build !errors_minimal
+
+package errors
+
+import "encoding/json"
+
+func init() {
+	RegisterCodec("json", jsonCodec{})
+}
+
+// MarshalJSON encodes err as the JSON document of its Envelope, made of the message, codes, merged fields
+// and the chain of links, each with its type, message and key-value pairs:
+//
+//	{
+//		"message": "not found: no rows",
+//		"code": 5,
+//		"http_status": 404,
+//		"fields": {"id": 5},
+//		"chain": {"type": "enriched", "message": "not found: no rows", "fields": ["id", 5], "err": {...}}
+//	}
+//
+// It is meant to persist errors, e.g. in outbox tables or job queues, and recreate them later with UnmarshalJSON and Envelope.Err.
+// If err is nil, MarshalJSON returns null.
+func MarshalJSON(err error, opts ...Option) ([]byte, error) {
+	return jsonCodec{}.Marshal(NewEnvelope(err, opts...))
+}
+
+// UnmarshalJSON decodes the Envelope encoded by MarshalJSON, Envelope.Err recreates the error.
+func UnmarshalJSON(b []byte) (*Envelope, error) {
+	return jsonCodec{}.Unmarshal(b)
+}
+
+// jsonCodec encodes the Envelope as JSON.
+type jsonCodec struct{}
+
+// Marshal implements Codec.
+func (jsonCodec) Marshal(e *Envelope) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Unmarshal implements Codec.
+func (jsonCodec) Unmarshal(b []byte) (*Envelope, error) {
+	var e *Envelope
+
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,111 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := errors.MarshalJSON(nil)
+		require.NoError(t, err)
+		require.Equal(t, "null", string(b))
+
+		env, err := errors.UnmarshalJSON(b)
+		require.NoError(t, err)
+
+		uErr := env.Err()
+		require.NoError(t, uErr)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := errors.New("not found")
+
+		b, err := errors.MarshalJSON(errors.WithCode(errors.EnrichWrapError(errors.New("no rows"), errNotFound, "id", 5), codes.NotFound))
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"message": "not found: no rows",
+			"code": 5,
+			"http_status": 404,
+			"fields": {"id": 5},
+			"chain": {
+				"type": "message",
+				"message": "not found: no rows",
+				"err": {
+					"type": "enriched",
+					"message": "not found: no rows",
+					"fields": ["id", 5],
+					"err": {
+						"type": "error",
+						"message": "not found: no rows",
+						"err": {"type": "string", "message": "not found"},
+						"cause": {"type": "string", "message": "no rows"}
+					}
+				}
+			}
+		}`, string(b))
+
+		env, err := errors.UnmarshalJSON(b)
+		require.NoError(t, err)
+
+		uErr := env.Err()
+		require.EqualError(t, uErr, "not found: no rows")
+		require.ErrorIs(t, uErr, errNotFound)
+		require.Equal(t, codes.NotFound, errors.CodeOf(uErr))
+		require.Equal(t, http.StatusNotFound, errors.HTTPStatusOf(uErr))
+	})
+
+	t.Run("http status", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := errors.MarshalJSON(errors.WithHTTPStatus(errors.New("gone"), http.StatusGone))
+		require.NoError(t, err)
+
+		env, err := errors.UnmarshalJSON(b)
+		require.NoError(t, err)
+
+		uErr := env.Err()
+		require.EqualError(t, uErr, "gone")
+		require.Equal(t, codes.Unknown, errors.CodeOf(uErr))
+		require.Equal(t, http.StatusGone, errors.HTTPStatusOf(uErr))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := errors.UnmarshalJSON([]byte("{"))
+		require.Error(t, err)
+	})
+}
+
+func TestEncode_json(t *testing.T) {
+	t.Parallel()
+
+	b, eErr := errors.Encode("json", errors.Enrich(errors.New("failed"), "id", 5))
+	require.NoError(t, eErr)
+	require.JSONEq(t, `{
+		"message": "failed",
+		"code": 2,
+		"http_status": 500,
+		"fields": {"id": 5},
+		"chain": {
+			"type": "enriched",
+			"message": "failed",
+			"fields": ["id", 5],
+			"err": {"type": "string", "message": "failed"}
+		}
+	}`, string(b))
+}
@@ -0,0 +1,139 @@
+package errors
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Kind is the transport-agnostic category of an error, see WithKind and KindOf.
+type Kind string
+
+// Kinds of errors.
+const (
+	KindUnknown          Kind = ""
+	KindInvalid          Kind = "invalid"
+	KindNotFound         Kind = "not_found"
+	KindConflict         Kind = "conflict"
+	KindUnauthenticated  Kind = "unauthenticated"
+	KindPermissionDenied Kind = "permission_denied"
+	KindRateLimited      Kind = "rate_limited"
+	KindPrecondition     Kind = "precondition"
+	KindCanceled         Kind = "canceled"
+	KindTimeout          Kind = "timeout"
+	KindUnavailable      Kind = "unavailable"
+	KindUnimplemented    Kind = "unimplemented"
+	KindInternal         Kind = "internal"
+)
+
+// kindCodes are the grpc codes of the kinds, the HTTP statuses are mapped from them.
+var kindCodes = map[Kind]codes.Code{
+	KindInvalid:          codes.InvalidArgument,
+	KindNotFound:         codes.NotFound,
+	KindConflict:         codes.AlreadyExists,
+	KindUnauthenticated:  codes.Unauthenticated,
+	KindPermissionDenied: codes.PermissionDenied,
+	KindRateLimited:      codes.ResourceExhausted,
+	KindPrecondition:     codes.FailedPrecondition,
+	KindCanceled:         codes.Canceled,
+	KindTimeout:          codes.DeadlineExceeded,
+	KindUnavailable:      codes.Unavailable,
+	KindUnimplemented:    codes.Unimplemented,
+	KindInternal:         codes.Internal,
+}
+
+// Code returns the grpc code of the kind, codes.Unknown for KindUnknown and unknown kinds.
+func (k Kind) Code() codes.Code {
+	if code, ok := kindCodes[k]; ok {
+		return code
+	}
+
+	return codes.Unknown
+}
+
+// kindFromCode returns the kind of a grpc code.
+func kindFromCode(code codes.Code) Kind {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return KindInvalid
+	case codes.NotFound:
+		return KindNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return KindConflict
+	case codes.Unauthenticated:
+		return KindUnauthenticated
+	case codes.PermissionDenied:
+		return KindPermissionDenied
+	case codes.ResourceExhausted:
+		return KindRateLimited
+	case codes.FailedPrecondition:
+		return KindPrecondition
+	case codes.Canceled:
+		return KindCanceled
+	case codes.DeadlineExceeded:
+		return KindTimeout
+	case codes.Unavailable:
+		return KindUnavailable
+	case codes.Unimplemented:
+		return KindUnimplemented
+	case codes.Internal, codes.DataLoss:
+		return KindInternal
+	default:
+		return KindUnknown
+	}
+}
+
+type kindError struct {
+	err  error
+	kind Kind
+}
+
+// Error implements the standard library error interface.
+func (ke *kindError) Error() string {
+	return ke.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (ke *kindError) Unwrap() error {
+	return ke.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (ke *kindError) Format(st fmt.State, verb rune) {
+	formatError(ke, st, verb)
+}
+
+// WithKind returns err classified as kind, see KindOf.
+//
+// Unless a code is attached with WithCode, the grpc code and the HTTP status of err are derived from the kind,
+// see CodeOf and HTTPStatusOf.
+// If err is nil, WithKind returns nil.
+func WithKind(err error, kind Kind) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &kindError{err: err, kind: kind}
+}
+
+// KindOf returns the outermost kind attached to err with WithKind, or else the kind of CodeOf(err),
+// so errors received from other services keep their category.
+//
+// If err is nil or has no known category, KindOf returns KindUnknown.
+func KindOf(err error) Kind {
+	if k, ok := kindOf(err); ok {
+		return k
+	}
+
+	return kindFromCode(CodeOf(err))
+}
+
+// kindOf returns the outermost kind attached to err with WithKind.
+func kindOf(err error) (Kind, bool) {
+	var ke *kindError
+	if As(err, &ke) {
+		return ke.kind, true
+	}
+
+	return KindUnknown, false
+}
@@ -0,0 +1,92 @@
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestKindOf(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+	errLimited := errors.WithKind(errFailed, errors.KindRateLimited)
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		kind   errors.Kind
+		code   codes.Code
+		status int
+	}{
+		{name: "nil", kind: errors.KindUnknown, code: codes.OK, status: http.StatusOK},
+		{name: "without kind", err: errFailed, kind: errors.KindUnknown, code: codes.Unknown, status: http.StatusInternalServerError},
+		{
+			name:   "with kind",
+			err:    errors.Wrap(errors.WithKind(errFailed, errors.KindNotFound), "get user"),
+			kind:   errors.KindNotFound,
+			code:   codes.NotFound,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "outermost kind",
+			err:    errors.WithKind(errors.WithKind(errFailed, errors.KindNotFound), errors.KindConflict),
+			kind:   errors.KindConflict,
+			code:   codes.AlreadyExists,
+			status: http.StatusConflict,
+		},
+		{
+			name:   "code wins",
+			err:    errors.WithCode(errors.WithKind(errFailed, errors.KindNotFound), codes.Internal),
+			kind:   errors.KindNotFound,
+			code:   codes.Internal,
+			status: http.StatusInternalServerError,
+		},
+		{
+			name:   "from code",
+			err:    errors.WithCode(errFailed, codes.Unauthenticated),
+			kind:   errors.KindUnauthenticated,
+			code:   codes.Unauthenticated,
+			status: http.StatusUnauthorized,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.kind, errors.KindOf(tc.err))
+			assert.Equal(t, tc.code, errors.CodeOf(tc.err))
+			assert.Equal(t, tc.status, errors.HTTPStatusOf(tc.err))
+			assert.Equal(t, tc.kind, errors.KindOf(errors.Clone(tc.err)))
+		})
+	}
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		dErr := fromStatus(t, toStatus(t, errLimited, errors.CodeOf(errLimited)))
+
+		assert.Equal(t, errors.KindRateLimited, errors.KindOf(dErr))
+		assert.Equal(t, codes.ResourceExhausted, errors.CodeOf(dErr))
+	})
+
+	for _, name := range codecs {
+		b, err := errors.Encode(name, errLimited)
+		require.NoError(t, err)
+
+		env, err := errors.Decode(name, b)
+		require.NoError(t, err)
+
+		dErr := env.Err()
+		assert.Equal(t, errors.KindRateLimited, errors.KindOf(dErr), name)
+	}
+
+	assert.NoError(t, errors.WithKind(nil, errors.KindInternal))
+	assert.Equal(t, codes.Unknown, errors.Kind("other").Code())
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// LazyValue is a field value computed only when the fields of the error are read, see Lazy.
+type LazyValue struct {
+	once sync.Once
+	f    func() interface{}
+	v    interface{}
+}
+
+// Lazy returns a field value computed by f the first time the fields of the error are read, e.g. by Fields,
+// the log adapters or the converters, so expensive values cost nothing for errors that are handled silently.
+//
+//	errors.Enrich(err, "state", errors.Lazy(func() interface{} { return machine.Dump() }))
+//
+// f is called at most once, the LazyValue is safe to be shared between goroutines.
+func Lazy(f func() interface{}) *LazyValue {
+	return &LazyValue{f: f}
+}
+
+// Value returns the value computed by the function of the LazyValue, computing it on the first call.
+func (l *LazyValue) Value() interface{} {
+	l.once.Do(func() {
+		if l.f != nil {
+			l.v = l.f()
+		}
+	})
+
+	return l.v
+}
+
+// String implements fmt.Stringer.
+func (l *LazyValue) String() string {
+	return fmt.Sprint(l.Value())
+}
+
+// LogValue implements slog.LogValuer.
+func (l *LazyValue) LogValue() slog.Value {
+	return slog.AnyValue(l.Value())
+}
+
+// appendResolved appends the key-value pairs of t to kv, with the lazy values computed.
+func appendResolved(kv []interface{}, t tuples) []interface{} {
+	for _, v := range t {
+		if l, ok := v.(*LazyValue); ok {
+			v = l.Value()
+		}
+
+		kv = append(kv, v)
+	}
+
+	return kv
+}
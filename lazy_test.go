@@ -0,0 +1,50 @@
+package errors_test
+
+import (
+	"bytes"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestLazy(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	dump := errors.Lazy(func() interface{} {
+		calls.Add(1)
+
+		return "state"
+	})
+
+	err := errors.Wrap(errors.Enrich(errors.New("failed"), "id", 5, "dump", dump), "oops")
+
+	require.EqualError(t, err, "oops: failed")
+	require.Zero(t, calls.Load(), "the value is not computed until the fields are read")
+
+	assert.Equal(t, []interface{}{"id", 5, "dump", "state"}, errors.Tuples(err))
+	assert.Equal(t, map[string]interface{}{"id": 5, "dump": "state"}, errors.Fields(err))
+
+	var buf bytes.Buffer
+
+	slog.New(slog.NewJSONHandler(&buf, nil)).Error("failed", "err", slog.GroupValue(errors.SlogAttrs(err)...))
+	assert.Contains(t, buf.String(), `"dump":"state"`)
+
+	assert.Equal(t, int32(1), calls.Load(), "the value is computed once")
+	assert.Equal(t, "state", dump.String())
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, map[string]interface{}{"id": float64(5), "dump": "state"},
+			errors.Fields(fromStatus(t, toStatus(t, err, codes.Internal))))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+type localizedError struct {
+	err     error
+	locale  string
+	message string
+}
+
+// Error implements the standard library error interface.
+func (le *localizedError) Error() string {
+	return le.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (le *localizedError) Unwrap() error {
+	return le.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (le *localizedError) Format(st fmt.State, verb rune) {
+	formatError(le, st, verb)
+}
+
+// WithLocalizedMessage returns err carrying a user-facing message in the locale, e.g. "en-US",
+// see LocalizedMessage. The message of err, internal, is kept in the chain.
+//
+// The message is sent to clients by ToStatus as an errdetails.LocalizedMessage detail, and as the title
+// of problem details documents.
+// If err is nil, WithLocalizedMessage returns nil.
+func WithLocalizedMessage(err error, locale, message string) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &localizedError{err: err, locale: locale, message: message}
+}
+
+// Translator provides the user-facing messages of errors in a locale, see SetTranslator.
+type Translator interface {
+	// Translate returns the message of err in the locale, and whether there is one.
+	Translate(err error, locale string) (string, bool)
+}
+
+type translatorHolder struct {
+	Translator
+}
+
+var translator atomic.Pointer[translatorHolder]
+
+// SetTranslator sets the Translator used by LocalizedMessage for the errors without a localized message
+// in the locale, nil removes it.
+func SetTranslator(t Translator) {
+	translator.Store(&translatorHolder{Translator: t})
+}
+
+// LocalizedMessage returns the user-facing message of err in the locale, and whether there is one, looked up in order:
+//   - the outermost message in the locale set by WithLocalizedMessage,
+//   - the message provided by the Translator, see SetTranslator,
+//   - the errdetails.LocalizedMessage detail in the locale of a grpc status carried by err.
+//
+// An empty locale matches any locale.
+func LocalizedMessage(err error, locale string) (string, bool) {
+	_, message, ok := localizedMessage(err, locale)
+
+	return message, ok
+}
+
+// localizedMessage returns the locale and the user-facing message of err in the locale, see LocalizedMessage.
+func localizedMessage(err error, locale string) (string, string, bool) {
+	if IsNil(err) {
+		return "", "", false
+	}
+
+	var found *localizedError
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if le, ok := err.(*localizedError); ok && (locale == "" || le.locale == locale) {
+			found = le
+		}
+
+		return found == nil
+	})
+
+	if found != nil {
+		return found.locale, found.message, true
+	}
+
+	if t := translator.Load(); t != nil && t.Translator != nil && locale != "" {
+		if message, ok := t.Translate(err, locale); ok {
+			return locale, message, true
+		}
+	}
+
+	return statusLocalizedMessage(err, locale)
+}
@@ -0,0 +1,122 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWithLocalizedMessage(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("user 5 not found in table users")
+	err := errors.WithLocalizedMessage(
+		errors.WithLocalizedMessage(errNotFound, "es-ES", "Usuario no encontrado"),
+		"en-US", "User not found",
+	)
+
+	t.Run("local", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.WithLocalizedMessage(nil, "en-US", "User not found"))
+		require.EqualError(t, err, "user 5 not found in table users")
+
+		msg, ok := errors.LocalizedMessage(err, "es-ES")
+		assert.True(t, ok)
+		assert.Equal(t, "Usuario no encontrado", msg)
+
+		msg, ok = errors.LocalizedMessage(err, "")
+		assert.True(t, ok)
+		assert.Equal(t, "User not found", msg)
+
+		_, ok = errors.LocalizedMessage(err, "fr-FR")
+		assert.False(t, ok)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		st := toStatus(t, err, codes.NotFound, errors.WithLocale("es-ES"))
+		require.Equal(t, "user 5 not found in table users", st.Message())
+
+		var lm *errdetails.LocalizedMessage
+
+		for _, d := range st.Details() {
+			if v, ok := d.(*errdetails.LocalizedMessage); ok {
+				lm = v
+			}
+		}
+
+		require.NotNil(t, lm)
+		assert.Equal(t, "es-ES", lm.GetLocale())
+
+		msg, ok := errors.LocalizedMessage(fromStatus(t, st), "es-ES")
+		assert.True(t, ok)
+		assert.Equal(t, "Usuario no encontrado", msg)
+
+		msg, ok = errors.LocalizedMessage(st.Err(), "")
+		assert.True(t, ok)
+		assert.Equal(t, "Usuario no encontrado", msg)
+	})
+
+	t.Run("problem", func(t *testing.T) {
+		t.Parallel()
+
+		p := errors.ToProblem(errors.WithCode(err, codes.NotFound))
+		assert.Equal(t, "User not found", p.Title)
+		assert.Equal(t, "user 5 not found in table users", p.Detail)
+	})
+
+	for _, codec := range codecs {
+		t.Run("codec "+codec, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(codec, err)
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(codec, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+
+			msg, ok := errors.LocalizedMessage(dErr, "en-US")
+			assert.True(t, ok)
+			assert.Equal(t, "User not found", msg)
+		})
+	}
+}
+
+type translator map[string]string
+
+func (t translator) Translate(err error, locale string) (string, bool) {
+	msg, ok := t[locale+"/"+err.Error()]
+
+	return msg, ok
+}
+
+//nolint:paralleltest // Changes the translator.
+func TestSetTranslator(t *testing.T) {
+	errors.SetTranslator(translator{"de-DE/not found": "Nicht gefunden"})
+	defer errors.SetTranslator(nil)
+
+	err := errors.New("not found")
+
+	msg, ok := errors.LocalizedMessage(err, "de-DE")
+	assert.True(t, ok)
+	assert.Equal(t, "Nicht gefunden", msg)
+
+	_, ok = errors.LocalizedMessage(err, "fr-FR")
+	assert.False(t, ok)
+
+	msg, ok = errors.LocalizedMessage(errors.WithLocalizedMessage(err, "de-DE", "Fehlt"), "de-DE")
+	assert.True(t, ok)
+	assert.Equal(t, "Fehlt", msg, "localized messages take precedence over the translator")
+
+	env := errors.NewEnvelope(err, errors.WithLocale("de-DE"))
+	assert.Equal(t, "Nicht gefunden", env.LocalizedMessage)
+}
@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// Keys of the fields attached by ClassifyNetwork.
+const (
+	NetHostKey = "host"
+	NetPortKey = "port"
+)
+
+// IsNetwork reports whether err is caused by a network failure: a DNS resolution error, a refused, reset
+// or aborted connection, or a failed TLS handshake.
+//
+// Network errors map to codes.Unavailable, see CodeOf, and are retryable unless the host does not exist,
+// see IsRetryable.
+func IsNetwork(err error) bool {
+	if IsNil(err) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if As(err, &dnsErr) {
+		return true
+	}
+
+	return isNetworkBlip(err)
+}
+
+// isNetworkBlip reports whether err is a transient network failure, that is a network error other than
+// a host not found.
+func isNetworkBlip(err error) bool {
+	var dnsErr *net.DNSError
+	if As(err, &dnsErr) {
+		return !dnsErr.IsNotFound
+	}
+
+	if Is(err, syscall.ECONNREFUSED) || Is(err, syscall.ECONNRESET) || Is(err, syscall.ECONNABORTED) {
+		return true
+	}
+
+	var (
+		recordErr tls.RecordHeaderError
+		alertErr  tls.AlertError
+	)
+
+	return As(err, &recordErr) || As(err, &alertErr)
+}
+
+// ClassifyNetwork returns err enriched with the host and port it failed on, as the NetHostKey and NetPortKey
+// fields, when it is a network error, see IsNetwork, err otherwise.
+//
+// The host and port are taken from the address of the *net.OpError or the name of the *net.DNSError of the chain.
+// If err is nil, ClassifyNetwork returns nil.
+func ClassifyNetwork(err error) error {
+	if !IsNetwork(err) {
+		return err
+	}
+
+	host, port := networkAddr(err)
+	if host == "" {
+		return err
+	}
+
+	if port == 0 {
+		return Enrich(err, NetHostKey, host)
+	}
+
+	return Enrich(err, NetHostKey, host, NetPortKey, port)
+}
+
+// networkAddr returns the remote host and port err failed on, if known.
+func networkAddr(err error) (string, int) {
+	var opErr *net.OpError
+	if As(err, &opErr) && opErr.Addr != nil {
+		host, p, sErr := net.SplitHostPort(opErr.Addr.String())
+		if sErr != nil {
+			return opErr.Addr.String(), 0
+		}
+
+		port, _ := strconv.Atoi(p) //nolint:errcheck
+
+		return host, port
+	}
+
+	var dnsErr *net.DNSError
+	if As(err, &dnsErr) {
+		return dnsErr.Name, 0
+	}
+
+	return "", 0
+}
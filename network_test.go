@@ -0,0 +1,84 @@
+package errors_test
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestClassifyNetwork(t *testing.T) {
+	t.Parallel()
+
+	addr := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5432}
+
+	for _, tc := range []struct {
+		name      string
+		err       error
+		network   bool
+		retryable bool
+		tuples    []interface{}
+	}{
+		{
+			name:      "connection refused",
+			err:       &net.OpError{Op: "dial", Net: "tcp", Addr: addr, Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+			network:   true,
+			retryable: true,
+			tuples:    []interface{}{errors.NetHostKey, "10.0.0.1", errors.NetPortKey, 5432},
+		},
+		{
+			name:      "connection reset",
+			err:       errors.Wrap(&net.OpError{Op: "read", Net: "tcp", Addr: addr, Err: syscall.ECONNRESET}, "query"),
+			network:   true,
+			retryable: true,
+			tuples:    []interface{}{errors.NetHostKey, "10.0.0.1", errors.NetPortKey, 5432},
+		},
+		{
+			name:      "dns temporary",
+			err:       &net.DNSError{Err: "server misbehaving", Name: "db.local", IsTemporary: true},
+			network:   true,
+			retryable: true,
+			tuples:    []interface{}{errors.NetHostKey, "db.local"},
+		},
+		{
+			name:    "dns not found",
+			err:     &net.DNSError{Err: "no such host", Name: "db.local", IsNotFound: true},
+			network: true,
+			tuples:  []interface{}{errors.NetHostKey, "db.local"},
+		},
+		{
+			name:      "tls handshake",
+			err:       errors.Wrap(tls.AlertError(40), "handshake"),
+			network:   true,
+			retryable: true,
+		},
+		{
+			name: "not network",
+			err:  errors.New("failed"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := errors.ClassifyNetwork(tc.err)
+
+			require.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.network, errors.IsNetwork(err))
+			assert.Equal(t, tc.retryable, errors.IsRetryable(err))
+			assert.Equal(t, tc.tuples, errors.Tuples(err))
+
+			if tc.network {
+				assert.Equal(t, codes.Unavailable, errors.CodeOf(err))
+			}
+		})
+	}
+
+	require.NoError(t, errors.ClassifyNetwork(nil))
+}
@@ -0,0 +1,34 @@
+//go:build !errors_minimal
+
+package errors
+
+import "reflect"
+
+// IsNil reports whether err is nil, including the case of a non-nil interface
+// holding a nil pointer (typed nil), e.g. a (*MyError)(nil) returned as error by third-party code.
+func IsNil(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(err)
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// sameError reports whether a and b are the same error value, without panicking on the error types which are
+// not comparable, e.g. slices of errors, which are never the same.
+func sameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	ta := reflect.TypeOf(a)
+
+	return ta == reflect.TypeOf(b) && ta.Comparable() && a == b
+}
@@ -0,0 +1,34 @@
+//go:build errors_minimal
+
+package errors
+
+import "unsafe"
+
+// iface is the layout of an interface value: its type and a pointer to its data, or the data itself for
+// pointer-shaped types.
+type iface struct {
+	typ  unsafe.Pointer
+	data unsafe.Pointer
+}
+
+// IsNil reports whether err is nil, including the case of a non-nil interface
+// holding a nil pointer (typed nil), e.g. a (*MyError)(nil) returned as error by third-party code.
+//
+// Without reflection, as in the errors_minimal build, the typed nils detected are the pointer-shaped
+// types: pointers, maps, channels and functions. Nil slices held by an error are not detected.
+func IsNil(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	return (*iface)(unsafe.Pointer(&err)).data == nil
+}
+
+// sameError reports whether a and b are the same error value, without panicking on the error types which are
+// not comparable, e.g. slices of errors.
+//
+// Without reflection, as in the errors_minimal build, the values are compared by identity: a and b are the same
+// if they have the same type and the same data, e.g. the same pointer.
+func sameError(a, b error) bool {
+	return *(*iface)(unsafe.Pointer(&a)) == *(*iface)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,68 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+type nilError struct{}
+
+func (*nilError) Error() string {
+	return "nil error"
+}
+
+func typedNil() error {
+	var err *nilError
+
+	return err
+}
+
+func TestIsNil(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, errors.IsNil(nil))
+	require.True(t, errors.IsNil(typedNil()))
+	require.False(t, errors.IsNil(errors.New("failed")))
+	require.False(t, errors.IsNil(&nilError{}))
+}
+
+func TestNoTypedNil(t *testing.T) {
+	t.Parallel()
+
+	for name, fn := range map[string]func(err error) error{
+		"Wrap": func(err error) error {
+			return errors.Wrap(err, "oops")
+		},
+		"Wrapf": func(err error) error {
+			return errors.Wrapf(err, "oops id %d", 5)
+		},
+		"WrapError": func(err error) error {
+			return errors.WrapError(err, typedNil())
+		},
+		"Enrich": func(err error) error {
+			return errors.Enrich(err, "id", 5)
+		},
+		"EnrichWrapError": func(err error) error {
+			return errors.EnrichWrapError(err, typedNil(), "id", 5)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// require.Nil would accept a typed nil, compare the interface itself.
+			require.True(t, fn(nil) == nil)        //nolint:testifylint
+			require.True(t, fn(typedNil()) == nil) //nolint:testifylint
+		})
+	}
+
+	t.Run("WrapError with supplied typed nil", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("failed")
+
+		require.Equal(t, err, errors.WrapError(err, typedNil()))
+	})
+}
@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is the name of a logical operation, e.g. "store.SaveUser", recorded along the error chain with WrapOp.
+type Op string
+
+type opError struct {
+	err error
+	op  Op
+}
+
+// Error implements the standard library error interface.
+func (oe *opError) Error() string {
+	return oe.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (oe *opError) Unwrap() error {
+	return oe.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (oe *opError) Format(st fmt.State, verb rune) {
+	formatError(oe, st, verb)
+}
+
+// WrapOp returns err annotated with the operation op, without changing its message, see Ops.
+//
+// The operations record the logical call path separately from the messages meant for humans,
+// they are sent to clients by ToStatus and recreated by FromStatus.
+//
+//	const op errors.Op = "store.SaveUser"
+//
+//	if err := s.db.Exec(ctx, query); err != nil {
+//		return errors.WrapOp(err, op)
+//	}
+//
+// If err is nil, WrapOp returns nil.
+func WrapOp(err error, op Op) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &opError{err: err, op: op}
+}
+
+// Ops returns the operations of the chain of err, from the outermost to the innermost: set by WrapOp
+// or received in the details of a grpc status. It returns nil if there are none.
+func Ops(err error) []string {
+	if IsNil(err) {
+		return nil
+	}
+
+	var ops []string
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if oe, ok := err.(*opError); ok {
+			ops = append(ops, string(oe.op))
+		}
+
+		return true
+	})
+
+	if ops != nil {
+		return ops
+	}
+
+	return statusOps(err)
+}
+
+// OpPath returns the operations of the chain of err as a breadcrumb, from the outermost to the innermost,
+// e.g. "api.CreateUser > store.SaveUser", see Ops.
+func OpPath(err error) string {
+	return strings.Join(Ops(err), " > ")
+}
@@ -0,0 +1,50 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestOps(t *testing.T) {
+	t.Parallel()
+
+	inner := errors.WrapOp(errors.New("no rows"), "store.SaveUser")
+	err := errors.WrapOp(fmt.Errorf("create: %w", inner), "api.CreateUser")
+
+	assert.Equal(t, "create: no rows", err.Error())
+	assert.Equal(t, []string{"api.CreateUser", "store.SaveUser"}, errors.Ops(err))
+	assert.Equal(t, "api.CreateUser > store.SaveUser", errors.OpPath(err))
+	assert.Equal(t, errors.Ops(err), errors.Ops(errors.Clone(err)))
+
+	assert.Nil(t, errors.WrapOp(nil, "store.SaveUser"))
+	assert.Nil(t, errors.Ops(nil))
+	assert.Nil(t, errors.Ops(errors.New("failed")))
+	assert.Empty(t, errors.OpPath(errors.New("failed")))
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		dErr := fromStatus(t, toStatus(t, err, codes.Internal))
+
+		assert.Equal(t, []string{"api.CreateUser", "store.SaveUser"}, errors.Ops(dErr))
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		t.Parallel()
+
+		b, mErr := json.Marshal(errors.NewEnvelope(err))
+		require.NoError(t, mErr)
+
+		var env errors.Envelope
+
+		require.NoError(t, json.Unmarshal(b, &env))
+		assert.Equal(t, []string{"api.CreateUser", "store.SaveUser"}, errors.Ops(env.Err()))
+	})
+}
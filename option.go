@@ -0,0 +1,145 @@
+package errors
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Option configures how errors are serialized, e.g. by ToStatus.
+type Option func(o *options)
+
+type options struct {
+	anonymizeKey    []byte
+	anonymizeFields map[string]struct{}
+	fieldPolicies   map[Classification]FieldPolicy
+	verbosity       *Verbosity
+	debugDetails    bool
+	locale          string
+	mergePolicy     MergePolicy
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		debugDetails: settings.Load().EmitDebugInfo,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithAnonymizedFields replaces the values of the fields with the given keys, e.g. "user_id" or "email",
+// by their hex encoded HMAC-SHA256 computed with key.
+//
+// Errors remain correlatable across logs without the raw identifiers being serialized.
+// The key also hashes the classified values of the HashField policy, see WithHashKey.
+func WithAnonymizedFields(key []byte, fields ...string) Option {
+	return func(o *options) {
+		o.anonymizeKey = key
+
+		if o.anonymizeFields == nil {
+			o.anonymizeFields = make(map[string]struct{}, len(fields))
+		}
+
+		for _, f := range fields {
+			o.anonymizeFields[f] = struct{}{}
+		}
+	}
+}
+
+// WithDebugDetails sets whether the stack trace of the error, when captured, is serialized,
+// e.g. sent by ToStatus as an errdetails.DebugInfo detail. It is meant for internal environments,
+// stack traces are not serialized by default, see Settings.EmitDebugInfo.
+func WithDebugDetails(enabled bool) Option {
+	return func(o *options) {
+		o.debugDetails = enabled
+	}
+}
+
+// WithLocale sets the locale of the user-facing message serialized with the error, e.g. by ToStatus,
+// see LocalizedMessage. Without locale, the outermost message set by WithLocalizedMessage is serialized.
+func WithLocale(locale string) Option {
+	return func(o *options) {
+		o.locale = locale
+	}
+}
+
+// WithMergePolicy sets which value of a key set at several levels of the chain is kept, e.g. by Fields,
+// DedupTuples, the Envelope and the log adapters. The innermost value is kept by default.
+func WithMergePolicy(p MergePolicy) Option {
+	return func(o *options) {
+		o.mergePolicy = p
+	}
+}
+
+// WithVerbosity sets the Verbosity of the log adapters, e.g. SlogAttrs, overriding the global one
+// for a single logger, see SetVerbosity.
+func WithVerbosity(v Verbosity) Option {
+	return func(o *options) {
+		o.verbosity = &v
+	}
+}
+
+// currentVerbosity returns the Verbosity set by WithVerbosity, the global one if not set.
+func (o *options) currentVerbosity() Verbosity {
+	if o.verbosity != nil {
+		return *o.verbosity
+	}
+
+	return CurrentVerbosity()
+}
+
+// merge returns the key-value pairs of a chain with every key once, according to the merge policy.
+func (o *options) merge(t []interface{}) tuples {
+	return tuples(t).dedup(o.mergePolicy)
+}
+
+// tuples returns the key-value pairs to serialize, applying the options and the policies of the classified values.
+func (o *options) tuples(t tuples) tuples {
+	if len(o.anonymizeFields) == 0 && !hasClassified(t) {
+		return t
+	}
+
+	result := make(tuples, 0, len(t))
+
+	for i := 0; i+1 < len(t); i += 2 {
+		key, v := t[i], t[i+1]
+
+		if c, ok := v.(Classified); ok {
+			if v, ok = o.classified(c); !ok {
+				continue
+			}
+		} else if k, ok := key.(string); ok {
+			if _, ok := o.anonymizeFields[k]; ok {
+				v = o.hash(v)
+			}
+		}
+
+		result = append(result, key, v)
+	}
+
+	return result
+}
+
+// hasClassified tells whether one of the values of t is Classified.
+func hasClassified(t tuples) bool {
+	for i := 1; i < len(t); i += 2 {
+		if _, ok := t[i].(Classified); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hash returns the hex encoded HMAC-SHA256 of the value computed with the anonymization key.
+func (o *options) hash(v interface{}) string {
+	mac := hmac.New(sha256.New, o.anonymizeKey)
+	_, _ = mac.Write([]byte(fmt.Sprint(v)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
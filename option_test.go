@@ -0,0 +1,51 @@
+package errors_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWithAnonymizedFields(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte("jane@example.com"))
+	hashed := hex.EncodeToString(mac.Sum(nil))
+
+	err := errors.Enrich(errors.New("failed"), "email", "jane@example.com", "id", 5)
+	opt := errors.WithAnonymizedFields(key, "email", "user_id")
+
+	t.Run("ToStatus", func(t *testing.T) {
+		t.Parallel()
+
+		cErr := fromStatus(t, toStatus(t, err, codes.Internal, opt))
+
+		require.Equal(t, []interface{}{"email", hashed, "id", 5.0}, enrichedOf(t, cErr).Tuples())
+	})
+
+	t.Run("SyslogStructuredData", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t,
+			`[error@32473 message="failed" code="Unknown" email="`+hashed+`" id="5"]`,
+			errors.SyslogStructuredData(err, opt),
+		)
+	})
+
+	t.Run("original error untouched", func(t *testing.T) {
+		t.Parallel()
+
+		errKV, ok := err.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+		require.Equal(t, []interface{}{"email", "jane@example.com", "id", 5}, errKV.Tuples())
+	})
+}
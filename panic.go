@@ -0,0 +1,168 @@
+package errors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ErrPanic is the error matched by the errors returned by Recover, HandlePanic and SafeGo.
+var ErrPanic = NewSentinel("errors.Panic", "panic")
+
+// PanicValueKey is the key of the field carrying the recovered panic value.
+const PanicValueKey = "panic"
+
+// Recover returns an error reporting the panic with the value recovered, nil if recovered is nil.
+//
+// The error matches ErrPanic, wraps recovered when it is an error, is enriched with the panic value
+// and always carries the stack trace of the panic, even when stack trace capture is disabled, see EnableStackTrace.
+//
+//	defer func() {
+//		if err := errors.Recover(recover()); err != nil {
+//			log.Error(err)
+//		}
+//	}()
+func Recover(recovered any) error {
+	// Skip runtime.Callers, captureStack and Recover.
+	return recoverPanic(recovered, 3)
+}
+
+// HandlePanic recovers a panic and stores it in errp as an error, see Recover.
+//
+// HandlePanic must be deferred directly.
+//
+//	func do() (err error) {
+//		defer errors.HandlePanic(&err)
+//
+//		...
+//	}
+func HandlePanic(errp *error) {
+	if r := recover(); r != nil {
+		// Skip runtime.Callers, captureStack and HandlePanic.
+		*errp = recoverPanic(r, 3)
+	}
+}
+
+// SafeGo runs fn in a new goroutine, recovering its panics and passing them to report as errors, see Recover.
+//
+// If report is nil, the panics are recovered and dropped.
+func SafeGo(fn func(), report func(err error)) {
+	go func() {
+		var err error
+
+		defer func() {
+			if err != nil && report != nil {
+				report(err)
+			}
+		}()
+
+		defer HandlePanic(&err)
+
+		fn()
+	}()
+}
+
+// recoverPanic returns the error reporting the recovered panic with the stack trace skipping skip frames,
+// not counting recoverPanic.
+func recoverPanic(recovered any, skip int) error {
+	if recovered == nil {
+		return nil
+	}
+
+	cause, ok := recovered.(error)
+	if !ok {
+		cause = &errorString{message: fmt.Sprint(recovered)}
+	}
+
+	stack := captureStack(skip + 1)
+
+	return &panicError{
+		err: Enrich(wrapError(cause, ErrPanic, stack), PanicValueKey, recovered),
+		info: PanicInfo{
+			Value:       fmt.Sprint(recovered),
+			Fingerprint: stack.fingerprint(),
+		},
+	}
+}
+
+// PanicInfo describes a recovered panic, see PanicInfoOf.
+type PanicInfo struct {
+	// Value is the panic value, as printed by fmt.Sprint.
+	Value string `json:"value"`
+	// Fingerprint identifies the site of the panic to group its occurrences, a truncated hash of the functions
+	// of the stack trace.
+	Fingerprint string `json:"fingerprint"`
+}
+
+type panicError struct {
+	err  error
+	info PanicInfo
+}
+
+// Error implements the standard library error interface.
+func (pe *panicError) Error() string {
+	return pe.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (pe *panicError) Unwrap() error {
+	return pe.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (pe *panicError) Format(st fmt.State, verb rune) {
+	formatError(pe, st, verb)
+}
+
+// IsPanic reports whether err reports a recovered panic, see Recover, including the errors recreated
+// from the status returned by the server interceptors.
+func IsPanic(err error) bool {
+	if IsNil(err) {
+		return false
+	}
+
+	if _, ok := PanicInfoOf(err); ok {
+		return true
+	}
+
+	return Is(err, ErrPanic)
+}
+
+// PanicInfoOf returns the description of the panic reported by err, and whether err reports one:
+// recovered by Recover or received in the status details sent by the server interceptors.
+func PanicInfoOf(err error) (PanicInfo, bool) {
+	if IsNil(err) {
+		return PanicInfo{}, false
+	}
+
+	var pe *panicError
+	if As(err, &pe) {
+		return pe.info, true
+	}
+
+	return statusPanicInfo(err)
+}
+
+// fingerprintLength is the number of bytes of the hash kept in the fingerprint of a panic.
+const fingerprintLength = 8
+
+// fingerprint returns a truncated hash of the functions of the stack trace, the frames of the runtime excluded,
+// so it does not change with the lines of the code, empty without stack trace.
+func (s stack) fingerprint() string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+
+	for _, f := range s.frames() {
+		if strings.HasPrefix(f.Function, "runtime.") {
+			continue
+		}
+
+		_, _ = h.Write([]byte(f.Function + "\n"))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:fingerprintLength])
+}
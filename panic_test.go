@@ -0,0 +1,157 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestRecover(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, errors.Recover(nil))
+
+	t.Run("value", func(t *testing.T) {
+		t.Parallel()
+
+		err := func() (err error) {
+			defer func() {
+				err = errors.Recover(recover())
+			}()
+
+			panic("boom")
+		}()
+
+		require.EqualError(t, err, "panic: boom")
+		require.ErrorIs(t, err, errors.ErrPanic)
+		assert.Equal(t, []interface{}{errors.PanicValueKey, "boom"}, errors.Tuples(err))
+		assert.Contains(t, fmt.Sprintf("%+v", err), "TestRecover")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		err := func() (err error) {
+			defer func() {
+				err = errors.Recover(recover())
+			}()
+
+			panic(io.EOF)
+		}()
+
+		require.EqualError(t, err, "panic: EOF")
+		require.ErrorIs(t, err, errors.ErrPanic)
+		require.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestHandlePanic(t *testing.T) {
+	t.Parallel()
+
+	do := func(fail bool) (err error) {
+		defer errors.HandlePanic(&err)
+
+		if fail {
+			panic("boom")
+		}
+
+		return io.EOF
+	}
+
+	require.ErrorIs(t, do(true), errors.ErrPanic)
+	require.Equal(t, io.EOF, do(false))
+}
+
+func TestSafeGo(t *testing.T) {
+	t.Parallel()
+
+	reported := make(chan error, 1)
+
+	errors.SafeGo(func() {
+		panic("boom")
+	}, func(err error) {
+		reported <- err
+	})
+
+	err := <-reported
+	require.EqualError(t, err, "panic: boom")
+	require.ErrorIs(t, err, errors.ErrPanic)
+}
+
+func TestPanicInfoOf(t *testing.T) {
+	t.Parallel()
+
+	recovered := func(v any) (err error) {
+		defer errors.HandlePanic(&err)
+
+		panic(v)
+	}
+
+	err := recovered("boom")
+
+	info, ok := errors.PanicInfoOf(err)
+	require.True(t, ok, "panic info is available")
+	assert.Equal(t, "boom", info.Value)
+	assert.Len(t, info.Fingerprint, 16)
+	assert.True(t, errors.IsPanic(err))
+
+	other, _ := errors.PanicInfoOf(recovered("other"))
+	assert.Equal(t, info.Fingerprint, other.Fingerprint, "panics of the same site have the same fingerprint")
+
+	elsewhere, _ := errors.PanicInfoOf(errors.Recover(io.EOF))
+	assert.NotEqual(t, info.Fingerprint, elsewhere.Fingerprint)
+
+	_, ok = errors.PanicInfoOf(errors.New("failed"))
+	assert.False(t, ok)
+	assert.False(t, errors.IsPanic(errors.New("failed")))
+	assert.False(t, errors.IsPanic(errors.New("panic")), "only the sentinel matches")
+	assert.False(t, errors.IsPanic(nil))
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		_, sErr := unaryServerInterceptor(t)(context.Background(), "req", nil,
+			func(context.Context, interface{}) (interface{}, error) {
+				panic("boom")
+			},
+		)
+
+		cErr := unaryClientInterceptor(t)(context.Background(), "/test.Service/Method", "req", nil, nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				return sErr
+			},
+		)
+
+		require.True(t, errors.IsPanic(cErr))
+
+		cInfo, ok := errors.PanicInfoOf(cErr)
+		require.True(t, ok, "panic info is received")
+		assert.Equal(t, "boom", cInfo.Value)
+		assert.NotEmpty(t, cInfo.Fingerprint)
+	})
+
+	for _, codec := range codecs {
+		t.Run(codec, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(codec, err)
+			require.NoError(t, eErr)
+
+			env, dErrErr := errors.Decode(codec, b)
+			require.NoError(t, dErrErr)
+
+			dErr := env.Err()
+
+			dInfo, ok := errors.PanicInfoOf(dErr)
+			require.True(t, ok, "panic info is decoded")
+			assert.Equal(t, info, dInfo)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package errors
+
+// Stage processes the Envelope of an error at a transport boundary, e.g. to redact or map it.
+//
+// Any func(*Envelope) *Envelope is a stage, returning nil drops the error details.
+type Stage func(e *Envelope) *Envelope
+
+// Pipeline is an ordered list of stages applied to the Envelope of errors before they are encoded
+// by the interceptors and HTTP handlers, e.g. Redact, Truncate then Map.
+//
+// It is assembled once and attached with WithPipeline.
+type Pipeline []Stage
+
+// NewPipeline returns a Pipeline applying the stages in order.
+func NewPipeline(stages ...Stage) Pipeline {
+	return stages
+}
+
+// Process applies the stages of the pipeline to e.
+func (p Pipeline) Process(e *Envelope) *Envelope {
+	for _, s := range p {
+		if e == nil {
+			return nil
+		}
+
+		e = s(e)
+	}
+
+	return e
+}
+
+// RedactFields returns a Stage removing the fields with the given keys from the envelope and its chain.
+func RedactFields(keys ...string) Stage {
+	redacted := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		redacted[k] = struct{}{}
+	}
+
+	return func(e *Envelope) *Envelope {
+		for k := range e.Fields {
+			if _, ok := redacted[k]; ok {
+				delete(e.Fields, k)
+			}
+		}
+
+		e.Chain.walk(func(l *Link) {
+			var fields []interface{}
+
+			for i := 0; i+1 < len(l.Fields); i += 2 {
+				if k, ok := l.Fields[i].(string); ok {
+					if _, ok := redacted[k]; ok {
+						continue
+					}
+				}
+
+				fields = append(fields, l.Fields[i], l.Fields[i+1])
+			}
+
+			l.Fields = fields
+		})
+
+		return e
+	}
+}
+
+// AllowFields returns a Stage keeping only the fields with the given keys in the envelope and its chain,
+// e.g. for the clients only entitled to some details.
+func AllowFields(keys ...string) Stage {
+	allowed := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		allowed[k] = struct{}{}
+	}
+
+	return func(e *Envelope) *Envelope {
+		for k := range e.Fields {
+			if _, ok := allowed[k]; !ok {
+				delete(e.Fields, k)
+			}
+		}
+
+		e.Chain.walk(func(l *Link) {
+			var fields []interface{}
+
+			for i := 0; i+1 < len(l.Fields); i += 2 {
+				if k, ok := l.Fields[i].(string); ok {
+					if _, ok := allowed[k]; ok {
+						fields = append(fields, l.Fields[i], l.Fields[i+1])
+					}
+				}
+			}
+
+			l.Fields = fields
+		})
+
+		return e
+	}
+}
+
+// Truncate returns a Stage truncating the messages of the envelope and its chain to at most n bytes.
+func Truncate(n int) Stage {
+	return func(e *Envelope) *Envelope {
+		e.Message = truncate(e.Message, n)
+
+		if e.Panic != nil {
+			e.Panic.Value = truncate(e.Panic.Value, n)
+		}
+
+		e.Chain.walk(func(l *Link) {
+			l.Message = truncate(l.Message, n)
+		})
+
+		return e
+	}
+}
+
+// walk calls fn for the link and every link it wraps.
+func (l *Link) walk(fn func(l *Link)) {
+	if l == nil {
+		return
+	}
+
+	fn(l)
+	l.Err.walk(fn)
+	l.Cause.walk(fn)
+
+	for _, jl := range l.Errs {
+		jl.walk(fn)
+	}
+}
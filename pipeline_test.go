@@ -0,0 +1,199 @@
+package errors_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	err := errors.EnrichWrapError(
+		errors.Enrich(errors.New("no rows for user 5"), "email", "jane@example.com"),
+		errors.New("not found"),
+		"id", 5,
+	)
+
+	mapNotFound := func(e *errors.Envelope) *errors.Envelope {
+		e.Code = codes.NotFound
+		e.HTTPStatus = http.StatusNotFound
+
+		return e
+	}
+
+	p := errors.NewPipeline(errors.RedactFields("email"), errors.Truncate(12), mapNotFound)
+
+	t.Run("Process", func(t *testing.T) {
+		t.Parallel()
+
+		e := p.Process(errors.NewEnvelope(err))
+		require.Equal(t, "not found...", e.Message)
+		require.Equal(t, codes.NotFound, e.Code)
+		require.Equal(t, map[string]interface{}{"id": 5}, e.Fields)
+
+		pErr := e.Err()
+		require.EqualError(t, pErr, "not found...")
+		errKV := enrichedOf(t, pErr)
+		require.Equal(t, []interface{}{"id", 5}, errKV.Tuples())
+		require.EqualError(t, errors.Cause(errors.Unwrap(errKV)), "no rows f...")
+
+		require.Nil(t, p.Process(nil))
+	})
+
+	t.Run("UnaryServerInterceptor", func(t *testing.T) {
+		t.Parallel()
+
+		_, sErr := unaryServerInterceptor(t, errors.WithPipeline(p))(context.Background(), "req", nil,
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, err
+			},
+		)
+
+		st, ok := status.FromError(sErr)
+		require.True(t, ok, "error is not a status error")
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "not found...", st.Message())
+	})
+
+	t.Run("Handler", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+
+		handler(t, func(http.ResponseWriter, *http.Request) error {
+			return err
+		}, errors.WithPipeline(p), errors.WithProblemFields()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found...","id":5}`,
+			rec.Body.String())
+	})
+}
+
+func TestAllowFields(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.Wrap(errors.Enrich(errors.New("failed"), "email", "jane@example.com", "id", 5), "oops"), "op", "get")
+
+	e := errors.AllowFields("id", "op")(errors.NewEnvelope(err))
+	require.Equal(t, map[string]interface{}{"id": 5, "op": "get"}, e.Fields)
+	require.Equal(t, map[string]interface{}{"id": 5, "op": "get"}, errors.Fields(e.Err()))
+}
+
+func TestWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	// Internal tenants get the stack traces and every field, the other ones a generic message and the id only.
+	policy := errors.PolicyFuncs{
+		EnvelopeOptionsFunc: func(ctx context.Context) []errors.Option {
+			return []errors.Option{errors.WithDebugDetails(tenant(ctx) == "internal")}
+		},
+		ProcessFunc: func(ctx context.Context, e *errors.Envelope) *errors.Envelope {
+			if tenant(ctx) == "internal" {
+				return e
+			}
+
+			e.LocalizedMessage = "Something went wrong"
+
+			return errors.AllowFields("id")(e)
+		},
+	}
+
+	err := errors.Enrich(errors.Invariant(false, "broken"), "id", 5, "table", "users")
+
+	call := func(t *testing.T, tenant string) error {
+		t.Helper()
+
+		ctx := errors.ContextWith(context.Background(), "tenant", tenant)
+
+		_, sErr := unaryServerInterceptor(t, errors.WithPolicy(policy))(ctx, "req", nil,
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, err
+			},
+		)
+
+		return sErr
+	}
+
+	t.Run("internal", func(t *testing.T) {
+		t.Parallel()
+
+		sErr := call(t, "internal")
+
+		require.NotEmpty(t, errors.RemoteStackTrace(sErr))
+		require.Equal(t, "users", errors.Fields(fromStatus(t, status.Convert(sErr)))["table"])
+	})
+
+	t.Run("external", func(t *testing.T) {
+		t.Parallel()
+
+		sErr := call(t, "acme")
+
+		require.Empty(t, errors.RemoteStackTrace(sErr))
+
+		cErr := fromStatus(t, status.Convert(sErr))
+		require.Equal(t, map[string]interface{}{"id": float64(5)}, errors.Fields(cErr))
+		msg, ok := errors.LocalizedMessage(sErr, "")
+		require.True(t, ok, "localized message is sent")
+		require.Equal(t, "Something went wrong", msg)
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		t.Parallel()
+
+		drop := errors.PolicyFuncs{
+			ProcessFunc: func(context.Context, *errors.Envelope) *errors.Envelope {
+				return nil
+			},
+		}
+
+		_, sErr := unaryServerInterceptor(t, errors.WithPolicy(drop))(context.Background(), "req", nil,
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, errors.WithCode(err, codes.NotFound)
+			},
+		)
+
+		st := status.Convert(sErr)
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "NotFound", st.Message(), "the internal message is not sent")
+		require.Empty(t, st.Details())
+	})
+
+	t.Run("http", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		handler(t, func(http.ResponseWriter, *http.Request) error {
+			return errors.WithHTTPStatus(err, http.StatusConflict)
+		}, errors.WithPolicy(policy)).ServeHTTP(rec, req.WithContext(errors.ContextWith(req.Context(), "tenant", "acme")))
+
+		require.Equal(t, http.StatusConflict, rec.Code)
+		require.Contains(t, rec.Body.String(), "Something went wrong")
+		require.NotContains(t, rec.Body.String(), "users")
+	})
+}
+
+// tenant returns the tenant attached to ctx.
+func tenant(ctx context.Context) string {
+	kv := errors.ContextTuples(ctx)
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i] == "tenant" {
+			s, _ := kv[i+1].(string) //nolint:errcheck
+
+			return s
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,56 @@
+package errors
+
+import "net/http"
+
+// ProblemContentType is the media type of Problem documents.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document.
+type Problem struct {
+	// Type is a URI reference identifying the problem type, "about:blank" when the problem
+	// has no semantics beyond the HTTP status.
+	Type string `json:"type,omitempty"`
+	// Title is a short summary of the problem type.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status.
+	Status int `json:"status,omitempty"`
+	// Detail is the explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference identifying this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Extensions are additional members of the document, they can not override the members above.
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// ToProblem converts err into an RFC 7807 problem details document.
+//
+// The status is resolved with HTTPStatusOf, the detail is the error message and the fields of the chain
+// are added as extensions.
+// If err is nil, ToProblem returns a document with status http.StatusOK.
+func ToProblem(err error, opts ...Option) Problem {
+	return NewEnvelope(err, opts...).problem()
+}
+
+// problem converts the envelope into a problem details document.
+func (e *Envelope) problem() Problem {
+	if e == nil {
+		return Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusOK),
+			Status: http.StatusOK,
+		}
+	}
+
+	title := http.StatusText(e.HTTPStatus)
+	if e.LocalizedMessage != "" {
+		title = e.LocalizedMessage
+	}
+
+	return Problem{
+		Type:       "about:blank",
+		Title:      title,
+		Status:     e.HTTPStatus,
+		Detail:     e.Message,
+		Extensions: e.Fields,
+	}
+}
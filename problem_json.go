@@ -0,0 +1,65 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// problem has the members of Problem without its methods.
+type problem Problem
+
+// MarshalJSON implements json.Marshaler, the extensions are marshaled as members of the document.
+func (p Problem) MarshalJSON() ([]byte, error) {
+	members := make(map[string]interface{}, len(p.Extensions)+5)
+
+	for k, v := range p.Extensions {
+		members[k] = v
+	}
+
+	b, err := json.Marshal(problem(p))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(members)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, the unknown members are unmarshaled as extensions.
+func (p *Problem) UnmarshalJSON(b []byte) error {
+	var members map[string]interface{}
+
+	if err := json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, (*problem)(p)); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"type", "title", "status", "detail", "instance"} {
+		delete(members, k)
+	}
+
+	p.Extensions = nil
+
+	if len(members) > 0 {
+		p.Extensions = members
+	}
+
+	return nil
+}
+
+// WriteProblem writes err as an application/problem+json response, see ToProblem.
+// The Retry-After header is set when err carries a retry delay, see WithRetryAfter.
+func WriteProblem(w http.ResponseWriter, err error, opts ...Option) {
+	env := NewEnvelope(err, opts...)
+
+	env.writeRetryAfter(w)
+	writeProblem(w, env.problem())
+}
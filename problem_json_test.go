@@ -0,0 +1,49 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestProblem_json(t *testing.T) {
+	t.Parallel()
+
+	p := errors.Problem{
+		Type:       "about:blank",
+		Title:      "Not Found",
+		Status:     http.StatusNotFound,
+		Detail:     "not found",
+		Extensions: map[string]interface{}{"id": 5.0, "status": "ignored"},
+	}
+
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found","id":5}`, string(b))
+
+	var up errors.Problem
+
+	require.NoError(t, json.Unmarshal(b, &up))
+
+	p.Extensions = map[string]interface{}{"id": 5.0}
+	require.Equal(t, p, up)
+}
+
+func TestWriteProblem(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+
+	errors.WriteProblem(rec, errors.Enrich(errors.WithHTTPStatus(errors.New("conflict"), http.StatusConflict), "id", 5))
+
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.Equal(t, errors.ProblemContentType, rec.Header().Get("Content-Type"))
+	require.JSONEq(t, `{"type":"about:blank","title":"Conflict","status":409,"detail":"conflict","id":5}`, rec.Body.String())
+}
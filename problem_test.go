@@ -0,0 +1,39 @@
+package errors_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestToProblem(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, errors.Problem{
+			Type:   "about:blank",
+			Title:  "OK",
+			Status: http.StatusOK,
+		}, errors.ToProblem(nil))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithCode(errors.EnrichWrapError(errors.New("no rows"), errors.New("not found"), "id", 5), codes.NotFound)
+
+		require.Equal(t, errors.Problem{
+			Type:       "about:blank",
+			Title:      "Not Found",
+			Status:     http.StatusNotFound,
+			Detail:     "not found: no rows",
+			Extensions: map[string]interface{}{"id": 5},
+		}, errors.ToProblem(err))
+	})
+}
@@ -0,0 +1,215 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	errorsv1 "github.com/dohernandez/errors/proto/dohernandez/errors/v1"
+)
+
+// ErrNotErrorChain is returned by FromProto for messages other than dohernandez.errors.v1.ErrorChain.
+var ErrNotErrorChain = NewSentinel("errors.NotErrorChain", "not an error chain message")
+
+// ErrorChainFullName is the full name of the protobuf message of an error chain, see ToProto.
+const ErrorChainFullName protoreflect.FullName = "dohernandez.errors.v1.ErrorChain"
+
+func init() {
+	RegisterCodec("proto", protoCodec{})
+}
+
+// ToProto returns err as a dohernandez.errors.v1.ErrorChain protobuf message, nil if err is nil.
+//
+// The message is described by proto/dohernandez/errors/v1/chain.proto, so it can be embedded
+// in other protobuf messages, e.g. as an anypb.Any.
+func ToProto(err error, opts ...Option) *errorsv1.ErrorChain {
+	e := NewEnvelope(err, opts...)
+	if e == nil {
+		return nil
+	}
+
+	return e.proto()
+}
+
+// proto returns the envelope as a dohernandez.errors.v1.ErrorChain message.
+func (e *Envelope) proto() *errorsv1.ErrorChain {
+	m := &errorsv1.ErrorChain{
+		Message:          e.Message,
+		Code:             int32(e.Code),       //nolint:gosec
+		HttpStatus:       int32(e.HTTPStatus), //nolint:gosec
+		Domain:           e.Domain,
+		Reason:           e.Reason,
+		StackEntries:     e.StackEntries,
+		Locale:           e.Locale,
+		LocalizedMessage: e.LocalizedMessage,
+		Ops:              e.Ops,
+		Kind:             string(e.Kind),
+	}
+
+	if e.Chain != nil {
+		m.Chain = e.Chain.proto()
+	}
+
+	if e.RetryAfter > 0 {
+		m.RetryAfter = durationpb.New(e.RetryAfter)
+	}
+
+	if e.Panic != nil {
+		m.Panic = &errorsv1.PanicInfo{Value: e.Panic.Value, Fingerprint: e.Panic.Fingerprint}
+	}
+
+	if len(e.Violations) > 0 {
+		m.Violations = badRequestOf(e.Violations).GetFieldViolations()
+	}
+
+	return m
+}
+
+// proto returns the link and the links it wraps as a dohernandez.errors.v1.Link message.
+func (l *Link) proto() *errorsv1.Link {
+	m := &errorsv1.Link{
+		Type:    l.Type,
+		Message: l.Message,
+		Name:    l.Name,
+	}
+
+	if len(l.Fields) > 0 {
+		m.Fields = encodeTuples(l.Fields)
+	}
+
+	if l.Err != nil {
+		m.Err = l.Err.proto()
+	}
+
+	if l.Cause != nil {
+		m.Cause = l.Cause.proto()
+	}
+
+	for _, jl := range l.Errs {
+		m.Errs = append(m.Errs, jl.proto())
+	}
+
+	return m
+}
+
+// FromProto decodes the Envelope of a dohernandez.errors.v1.ErrorChain protobuf message, see ToProto,
+// Envelope.Err recreates the error.
+//
+// m may be any message with the dohernandez.errors.v1.ErrorChain schema, e.g. a dynamicpb.Message.
+// If m is nil, FromProto returns nil.
+func FromProto(m proto.Message) (*Envelope, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return nil, nil //nolint:nilnil
+	}
+
+	ec, ok := m.(*errorsv1.ErrorChain)
+	if !ok {
+		if name := m.ProtoReflect().Descriptor().FullName(); name != ErrorChainFullName {
+			return nil, Enrich(ErrNotErrorChain, "message", string(name))
+		}
+
+		// Messages of other implementations of the schema are read through their wire format.
+		b, err := proto.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+
+		ec = &errorsv1.ErrorChain{}
+		if err := proto.Unmarshal(b, ec); err != nil {
+			return nil, err
+		}
+	}
+
+	return envelopeFromErrorChain(ec), nil
+}
+
+// envelopeFromErrorChain returns the envelope of a dohernandez.errors.v1.ErrorChain message.
+func envelopeFromErrorChain(m *errorsv1.ErrorChain) *Envelope {
+	e := &Envelope{
+		Message:          m.GetMessage(),
+		Code:             codes.Code(m.GetCode()), //nolint:gosec
+		HTTPStatus:       int(m.GetHttpStatus()),
+		Domain:           m.GetDomain(),
+		Reason:           m.GetReason(),
+		StackEntries:     m.GetStackEntries(),
+		Locale:           m.GetLocale(),
+		LocalizedMessage: m.GetLocalizedMessage(),
+		Ops:              m.GetOps(),
+		Kind:             Kind(m.GetKind()),
+	}
+
+	if m.GetChain() != nil {
+		e.Chain = linkFromProto(m.GetChain())
+		e.Fields = tuples(keysAndValues(e.Chain.decode())).fields()
+	}
+
+	if m.GetRetryAfter() != nil {
+		e.RetryAfter = m.GetRetryAfter().AsDuration()
+	}
+
+	if p := m.GetPanic(); p != nil {
+		e.Panic = &PanicInfo{Value: p.GetValue(), Fingerprint: p.GetFingerprint()}
+	}
+
+	for _, fv := range m.GetViolations() {
+		e.Violations = append(e.Violations, FieldViolation{Field: fv.GetField(), Description: fv.GetDescription()})
+	}
+
+	return e
+}
+
+// linkFromProto decodes the dohernandez.errors.v1.Link message and the links it wraps.
+func linkFromProto(m *errorsv1.Link) *Link {
+	l := &Link{
+		Type:    m.GetType(),
+		Message: m.GetMessage(),
+		Name:    m.GetName(),
+	}
+
+	if m.GetFields() != nil {
+		l.Fields = m.GetFields().AsSlice()
+	}
+
+	if m.GetErr() != nil {
+		l.Err = linkFromProto(m.GetErr())
+	}
+
+	if m.GetCause() != nil {
+		l.Cause = linkFromProto(m.GetCause())
+	}
+
+	for _, jl := range m.GetErrs() {
+		l.Errs = append(l.Errs, linkFromProto(jl))
+	}
+
+	return l
+}
+
+// protoCodec encodes the Envelope in the wire format of dohernandez.errors.v1.ErrorChain.
+type protoCodec struct{}
+
+// Marshal implements Codec.
+func (protoCodec) Marshal(e *Envelope) ([]byte, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	return proto.Marshal(e.proto())
+}
+
+// Unmarshal implements Codec.
+func (protoCodec) Unmarshal(b []byte) (*Envelope, error) {
+	if len(b) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	m := &errorsv1.ErrorChain{}
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+
+	return envelopeFromErrorChain(m), nil
+}
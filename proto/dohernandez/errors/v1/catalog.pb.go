@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: dohernandez/errors/v1/catalog.proto
+
+package errorsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListErrorsRequest is the request of ErrorCatalogService.ListErrors.
+type ListErrorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// locale is the locale of the user-facing messages, empty for any locale.
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListErrorsRequest) Reset() {
+	*x = ListErrorsRequest{}
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListErrorsRequest) ProtoMessage() {}
+
+func (x *ListErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ListErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListErrorsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// ListErrorsResponse is the response of ErrorCatalogService.ListErrors.
+type ListErrorsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// errors are the registered errors, ordered by name.
+	Errors        []*CatalogEntry `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListErrorsResponse) Reset() {
+	*x = ListErrorsResponse{}
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListErrorsResponse) ProtoMessage() {}
+
+func (x *ListErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListErrorsResponse.ProtoReflect.Descriptor instead.
+func (*ListErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListErrorsResponse) GetErrors() []*CatalogEntry {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// CatalogEntry describes a registered error, see errors.Catalog.
+type CatalogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the unique name of the sentinel, e.g. "users.NotFound".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// message is the error message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// code is the grpc code of the error.
+	Code int32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// http_status is the HTTP status of the error.
+	HttpStatus int32 `protobuf:"varint,4,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// kind is the category of the error, e.g. "not_found".
+	Kind string `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	// retryable tells whether the operations failing with the error can be retried.
+	Retryable bool `protobuf:"varint,6,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	// localized_message is the user-facing message of the error in the requested locale, if any.
+	LocalizedMessage string `protobuf:"bytes,7,opt,name=localized_message,json=localizedMessage,proto3" json:"localized_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CatalogEntry) Reset() {
+	*x = CatalogEntry{}
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEntry) ProtoMessage() {}
+
+func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogEntry.ProtoReflect.Descriptor instead.
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CatalogEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CatalogEntry) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *CatalogEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+func (x *CatalogEntry) GetLocalizedMessage() string {
+	if x != nil {
+		return x.LocalizedMessage
+	}
+	return ""
+}
+
+var File_dohernandez_errors_v1_catalog_proto protoreflect.FileDescriptor
+
+const file_dohernandez_errors_v1_catalog_proto_rawDesc = "" +
+	"\n" +
+	"#dohernandez/errors/v1/catalog.proto\x12\x15dohernandez.errors.v1\"+\n" +
+	"\x11ListErrorsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"Q\n" +
+	"\x12ListErrorsResponse\x12;\n" +
+	"\x06errors\x18\x01 \x03(\v2#.dohernandez.errors.v1.CatalogEntryR\x06errors\"\xd0\x01\n" +
+	"\fCatalogEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x1f\n" +
+	"\vhttp_status\x18\x04 \x01(\x05R\n" +
+	"httpStatus\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tretryable\x18\x06 \x01(\bR\tretryable\x12+\n" +
+	"\x11localized_message\x18\a \x01(\tR\x10localizedMessage2x\n" +
+	"\x13ErrorCatalogService\x12a\n" +
+	"\n" +
+	"ListErrors\x12(.dohernandez.errors.v1.ListErrorsRequest\x1a).dohernandez.errors.v1.ListErrorsResponseBDZBgithub.com/dohernandez/errors/proto/dohernandez/errors/v1;errorsv1b\x06proto3"
+
+var (
+	file_dohernandez_errors_v1_catalog_proto_rawDescOnce sync.Once
+	file_dohernandez_errors_v1_catalog_proto_rawDescData []byte
+)
+
+func file_dohernandez_errors_v1_catalog_proto_rawDescGZIP() []byte {
+	file_dohernandez_errors_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_dohernandez_errors_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dohernandez_errors_v1_catalog_proto_rawDesc), len(file_dohernandez_errors_v1_catalog_proto_rawDesc)))
+	})
+	return file_dohernandez_errors_v1_catalog_proto_rawDescData
+}
+
+var file_dohernandez_errors_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dohernandez_errors_v1_catalog_proto_goTypes = []any{
+	(*ListErrorsRequest)(nil),  // 0: dohernandez.errors.v1.ListErrorsRequest
+	(*ListErrorsResponse)(nil), // 1: dohernandez.errors.v1.ListErrorsResponse
+	(*CatalogEntry)(nil),       // 2: dohernandez.errors.v1.CatalogEntry
+}
+var file_dohernandez_errors_v1_catalog_proto_depIdxs = []int32{
+	2, // 0: dohernandez.errors.v1.ListErrorsResponse.errors:type_name -> dohernandez.errors.v1.CatalogEntry
+	0, // 1: dohernandez.errors.v1.ErrorCatalogService.ListErrors:input_type -> dohernandez.errors.v1.ListErrorsRequest
+	1, // 2: dohernandez.errors.v1.ErrorCatalogService.ListErrors:output_type -> dohernandez.errors.v1.ListErrorsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_dohernandez_errors_v1_catalog_proto_init() }
+func file_dohernandez_errors_v1_catalog_proto_init() {
+	if File_dohernandez_errors_v1_catalog_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dohernandez_errors_v1_catalog_proto_rawDesc), len(file_dohernandez_errors_v1_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dohernandez_errors_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_dohernandez_errors_v1_catalog_proto_depIdxs,
+		MessageInfos:      file_dohernandez_errors_v1_catalog_proto_msgTypes,
+	}.Build()
+	File_dohernandez_errors_v1_catalog_proto = out.File
+	file_dohernandez_errors_v1_catalog_proto_goTypes = nil
+	file_dohernandez_errors_v1_catalog_proto_depIdxs = nil
+}
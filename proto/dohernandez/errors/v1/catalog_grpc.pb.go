@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: dohernandez/errors/v1/catalog.proto
+
+package errorsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ErrorCatalogService_ListErrors_FullMethodName = "/dohernandez.errors.v1.ErrorCatalogService/ListErrors"
+)
+
+// ErrorCatalogServiceClient is the client API for ErrorCatalogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ErrorCatalogService serves the catalog of the errors a service can emit, see errors.NewCatalogServer.
+type ErrorCatalogServiceClient interface {
+	// ListErrors returns the registered errors, ordered by name.
+	ListErrors(ctx context.Context, in *ListErrorsRequest, opts ...grpc.CallOption) (*ListErrorsResponse, error)
+}
+
+type errorCatalogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewErrorCatalogServiceClient(cc grpc.ClientConnInterface) ErrorCatalogServiceClient {
+	return &errorCatalogServiceClient{cc}
+}
+
+func (c *errorCatalogServiceClient) ListErrors(ctx context.Context, in *ListErrorsRequest, opts ...grpc.CallOption) (*ListErrorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListErrorsResponse)
+	err := c.cc.Invoke(ctx, ErrorCatalogService_ListErrors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ErrorCatalogServiceServer is the server API for ErrorCatalogService service.
+// All implementations must embed UnimplementedErrorCatalogServiceServer
+// for forward compatibility.
+//
+// ErrorCatalogService serves the catalog of the errors a service can emit, see errors.NewCatalogServer.
+type ErrorCatalogServiceServer interface {
+	// ListErrors returns the registered errors, ordered by name.
+	ListErrors(context.Context, *ListErrorsRequest) (*ListErrorsResponse, error)
+	mustEmbedUnimplementedErrorCatalogServiceServer()
+}
+
+// UnimplementedErrorCatalogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedErrorCatalogServiceServer struct{}
+
+func (UnimplementedErrorCatalogServiceServer) ListErrors(context.Context, *ListErrorsRequest) (*ListErrorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListErrors not implemented")
+}
+func (UnimplementedErrorCatalogServiceServer) mustEmbedUnimplementedErrorCatalogServiceServer() {}
+func (UnimplementedErrorCatalogServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeErrorCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ErrorCatalogServiceServer will
+// result in compilation errors.
+type UnsafeErrorCatalogServiceServer interface {
+	mustEmbedUnimplementedErrorCatalogServiceServer()
+}
+
+func RegisterErrorCatalogServiceServer(s grpc.ServiceRegistrar, srv ErrorCatalogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedErrorCatalogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ErrorCatalogService_ServiceDesc, srv)
+}
+
+func _ErrorCatalogService_ListErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListErrorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorCatalogServiceServer).ListErrors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorCatalogService_ListErrors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorCatalogServiceServer).ListErrors(ctx, req.(*ListErrorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ErrorCatalogService_ServiceDesc is the grpc.ServiceDesc for ErrorCatalogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ErrorCatalogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dohernandez.errors.v1.ErrorCatalogService",
+	HandlerType: (*ErrorCatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListErrors",
+			Handler:    _ErrorCatalogService_ListErrors_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dohernandez/errors/v1/catalog.proto",
+}
@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: dohernandez/errors/v1/chain.proto
+
+package errorsv1
+
+import (
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorChain is an error with its chain, see errors.ToProto.
+type ErrorChain struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message is the error message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// code is the grpc code of the error.
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// http_status is the HTTP status of the error.
+	HttpStatus int32 `protobuf:"varint,3,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// chain is the outermost link of the error chain.
+	Chain *Link `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	// retry_after is the delay after which the operation can be retried.
+	RetryAfter *durationpb.Duration `protobuf:"bytes,5,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	// violations are the invalid fields of the request.
+	Violations []*errdetails.BadRequest_FieldViolation `protobuf:"bytes,6,rep,name=violations,proto3" json:"violations,omitempty"`
+	// domain is the domain of the reason.
+	Domain string `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain,omitempty"`
+	// reason is the machine-readable identity of the error within the domain.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// stack_entries are the frames of the stack trace of the error, only set with debug details.
+	StackEntries []string `protobuf:"bytes,9,rep,name=stack_entries,json=stackEntries,proto3" json:"stack_entries,omitempty"`
+	// locale is the locale of the localized message.
+	Locale string `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	// localized_message is the user-facing message of the error.
+	LocalizedMessage string `protobuf:"bytes,11,opt,name=localized_message,json=localizedMessage,proto3" json:"localized_message,omitempty"`
+	// panic describes the recovered panic reported by the error.
+	Panic *PanicInfo `protobuf:"bytes,12,opt,name=panic,proto3" json:"panic,omitempty"`
+	// ops are the operations of the chain, from the outermost to the innermost.
+	Ops []string `protobuf:"bytes,13,rep,name=ops,proto3" json:"ops,omitempty"`
+	// kind is the category of the error, e.g. "not_found".
+	Kind          string `protobuf:"bytes,14,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorChain) Reset() {
+	*x = ErrorChain{}
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorChain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorChain) ProtoMessage() {}
+
+func (x *ErrorChain) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorChain.ProtoReflect.Descriptor instead.
+func (*ErrorChain) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_chain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ErrorChain) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorChain) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ErrorChain) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *ErrorChain) GetChain() *Link {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetViolations() []*errdetails.BadRequest_FieldViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ErrorChain) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ErrorChain) GetStackEntries() []string {
+	if x != nil {
+		return x.StackEntries
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ErrorChain) GetLocalizedMessage() string {
+	if x != nil {
+		return x.LocalizedMessage
+	}
+	return ""
+}
+
+func (x *ErrorChain) GetPanic() *PanicInfo {
+	if x != nil {
+		return x.Panic
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetOps() []string {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *ErrorChain) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// PanicInfo describes a recovered panic, see errors.PanicInfoOf.
+type PanicInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the panic value.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// fingerprint identifies the site of the panic.
+	Fingerprint   string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PanicInfo) Reset() {
+	*x = PanicInfo{}
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PanicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanicInfo) ProtoMessage() {}
+
+func (x *PanicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanicInfo.ProtoReflect.Descriptor instead.
+func (*PanicInfo) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_chain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PanicInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PanicInfo) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+// Link is a link of an error chain.
+type Link struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the type of the link: string, message, error, enriched, join or sentinel.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// message is the message of the link.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// fields are the key-value pairs of an enriched link.
+	Fields *structpb.ListValue `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	// err is the wrapped error.
+	Err *Link `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
+	// cause is the cause of an error link.
+	Cause *Link `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause,omitempty"`
+	// errs are the errors of a join link.
+	Errs []*Link `protobuf:"bytes,6,rep,name=errs,proto3" json:"errs,omitempty"`
+	// name is the name of a sentinel link.
+	Name          string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Link) Reset() {
+	*x = Link{}
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Link) ProtoMessage() {}
+
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_dohernandez_errors_v1_chain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_dohernandez_errors_v1_chain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Link) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Link) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Link) GetFields() *structpb.ListValue {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Link) GetErr() *Link {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+func (x *Link) GetCause() *Link {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+func (x *Link) GetErrs() []*Link {
+	if x != nil {
+		return x.Errs
+	}
+	return nil
+}
+
+func (x *Link) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_dohernandez_errors_v1_chain_proto protoreflect.FileDescriptor
+
+const file_dohernandez_errors_v1_chain_proto_rawDesc = "" +
+	"\n" +
+	"!dohernandez/errors/v1/chain.proto\x12\x15dohernandez.errors.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1egoogle/rpc/error_details.proto\"\x89\x04\n" +
+	"\n" +
+	"ErrorChain\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x1f\n" +
+	"\vhttp_status\x18\x03 \x01(\x05R\n" +
+	"httpStatus\x121\n" +
+	"\x05chain\x18\x04 \x01(\v2\x1b.dohernandez.errors.v1.LinkR\x05chain\x12:\n" +
+	"\vretry_after\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"retryAfter\x12E\n" +
+	"\n" +
+	"violations\x18\x06 \x03(\v2%.google.rpc.BadRequest.FieldViolationR\n" +
+	"violations\x12\x16\n" +
+	"\x06domain\x18\a \x01(\tR\x06domain\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12#\n" +
+	"\rstack_entries\x18\t \x03(\tR\fstackEntries\x12\x16\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tR\x06locale\x12+\n" +
+	"\x11localized_message\x18\v \x01(\tR\x10localizedMessage\x126\n" +
+	"\x05panic\x18\f \x01(\v2 .dohernandez.errors.v1.PanicInfoR\x05panic\x12\x10\n" +
+	"\x03ops\x18\r \x03(\tR\x03ops\x12\x12\n" +
+	"\x04kind\x18\x0e \x01(\tR\x04kind\"C\n" +
+	"\tPanicInfo\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\"\x8f\x02\n" +
+	"\x04Link\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x06fields\x18\x03 \x01(\v2\x1a.google.protobuf.ListValueR\x06fields\x12-\n" +
+	"\x03err\x18\x04 \x01(\v2\x1b.dohernandez.errors.v1.LinkR\x03err\x121\n" +
+	"\x05cause\x18\x05 \x01(\v2\x1b.dohernandez.errors.v1.LinkR\x05cause\x12/\n" +
+	"\x04errs\x18\x06 \x03(\v2\x1b.dohernandez.errors.v1.LinkR\x04errs\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04nameBDZBgithub.com/dohernandez/errors/proto/dohernandez/errors/v1;errorsv1b\x06proto3"
+
+var (
+	file_dohernandez_errors_v1_chain_proto_rawDescOnce sync.Once
+	file_dohernandez_errors_v1_chain_proto_rawDescData []byte
+)
+
+func file_dohernandez_errors_v1_chain_proto_rawDescGZIP() []byte {
+	file_dohernandez_errors_v1_chain_proto_rawDescOnce.Do(func() {
+		file_dohernandez_errors_v1_chain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dohernandez_errors_v1_chain_proto_rawDesc), len(file_dohernandez_errors_v1_chain_proto_rawDesc)))
+	})
+	return file_dohernandez_errors_v1_chain_proto_rawDescData
+}
+
+var file_dohernandez_errors_v1_chain_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_dohernandez_errors_v1_chain_proto_goTypes = []any{
+	(*ErrorChain)(nil),          // 0: dohernandez.errors.v1.ErrorChain
+	(*PanicInfo)(nil),           // 1: dohernandez.errors.v1.PanicInfo
+	(*Link)(nil),                // 2: dohernandez.errors.v1.Link
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+	(*errdetails.BadRequest_FieldViolation)(nil), // 4: google.rpc.BadRequest.FieldViolation
+	(*structpb.ListValue)(nil),                   // 5: google.protobuf.ListValue
+}
+var file_dohernandez_errors_v1_chain_proto_depIdxs = []int32{
+	2, // 0: dohernandez.errors.v1.ErrorChain.chain:type_name -> dohernandez.errors.v1.Link
+	3, // 1: dohernandez.errors.v1.ErrorChain.retry_after:type_name -> google.protobuf.Duration
+	4, // 2: dohernandez.errors.v1.ErrorChain.violations:type_name -> google.rpc.BadRequest.FieldViolation
+	1, // 3: dohernandez.errors.v1.ErrorChain.panic:type_name -> dohernandez.errors.v1.PanicInfo
+	5, // 4: dohernandez.errors.v1.Link.fields:type_name -> google.protobuf.ListValue
+	2, // 5: dohernandez.errors.v1.Link.err:type_name -> dohernandez.errors.v1.Link
+	2, // 6: dohernandez.errors.v1.Link.cause:type_name -> dohernandez.errors.v1.Link
+	2, // 7: dohernandez.errors.v1.Link.errs:type_name -> dohernandez.errors.v1.Link
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_dohernandez_errors_v1_chain_proto_init() }
+func file_dohernandez_errors_v1_chain_proto_init() {
+	if File_dohernandez_errors_v1_chain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dohernandez_errors_v1_chain_proto_rawDesc), len(file_dohernandez_errors_v1_chain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dohernandez_errors_v1_chain_proto_goTypes,
+		DependencyIndexes: file_dohernandez_errors_v1_chain_proto_depIdxs,
+		MessageInfos:      file_dohernandez_errors_v1_chain_proto_msgTypes,
+	}.Build()
+	File_dohernandez_errors_v1_chain_proto = out.File
+	file_dohernandez_errors_v1_chain_proto_goTypes = nil
+	file_dohernandez_errors_v1_chain_proto_depIdxs = nil
+}
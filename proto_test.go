@@ -0,0 +1,92 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestToProto(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	err := errors.WithCode(errors.Enrich(errors.WrapError(errors.New("no rows"), errNotFound), "id", 5), codes.NotFound)
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		m := errors.ToProto(err)
+		require.Equal(t, errors.ErrorChainFullName, m.ProtoReflect().Descriptor().FullName())
+
+		b, mErr := proto.Marshal(m)
+		require.NoError(t, mErr)
+
+		a, mErr := anypb.New(m)
+		require.NoError(t, mErr)
+
+		um, mErr := a.UnmarshalNew()
+		require.NoError(t, mErr)
+		require.NotEmpty(t, b)
+
+		env, mErr := errors.FromProto(um)
+		require.NoError(t, mErr)
+
+		dErr := env.Err()
+		require.EqualError(t, dErr, "not found: no rows")
+		require.ErrorIs(t, dErr, errNotFound)
+
+		assert.Equal(t, []interface{}{"id", float64(5)}, enrichedOf(t, dErr).Tuples())
+	})
+
+	t.Run("dynamic message", func(t *testing.T) {
+		t.Parallel()
+
+		b, mErr := proto.Marshal(errors.ToProto(err))
+		require.NoError(t, mErr)
+
+		md, mErr := protoregistry.GlobalFiles.FindDescriptorByName(errors.ErrorChainFullName)
+		require.NoError(t, mErr)
+
+		m := dynamicpb.NewMessage(md.(protoreflect.MessageDescriptor))
+		require.NoError(t, proto.Unmarshal(b, m))
+
+		env, mErr := errors.FromProto(m)
+		require.NoError(t, mErr)
+
+		dErr := env.Err()
+		require.EqualError(t, dErr, "not found: no rows")
+		require.ErrorIs(t, dErr, errNotFound)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, errors.ToProto(nil))
+
+		env, mErr := errors.FromProto(nil)
+		require.NoError(t, mErr)
+
+		dErr := env.Err()
+		assert.True(t, dErr == nil)
+	})
+
+	t.Run("other message", func(t *testing.T) {
+		t.Parallel()
+
+		env, mErr := errors.FromProto(wrapperspb.String("boom"))
+		require.ErrorIs(t, mErr, errors.ErrNotErrorChain)
+		assert.Nil(t, env)
+	})
+}
@@ -0,0 +1,52 @@
+package errors
+
+import "fmt"
+
+type reasonError struct {
+	err    error
+	domain string
+	reason string
+}
+
+// Error implements the standard library error interface.
+func (re *reasonError) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (re *reasonError) Unwrap() error {
+	return re.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (re *reasonError) Format(st fmt.State, verb rune) {
+	formatError(re, st, verb)
+}
+
+// WithReason returns err carrying a machine-readable identity: the reason, e.g. "USER_NOT_FOUND",
+// unique within the domain, e.g. "users.example.com", see ReasonOf.
+//
+// The reason is sent to clients by ToStatus as an errdetails.ErrorInfo detail, and recreated by FromStatus.
+// If err is nil, WithReason returns nil.
+func WithReason(err error, domain, reason string) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &reasonError{err: err, domain: domain, reason: reason}
+}
+
+// ReasonOf returns the domain and the reason of err, and whether it carries one: the outermost set by WithReason
+// or received in the errdetails.ErrorInfo detail of a grpc status.
+func ReasonOf(err error) (domain, reason string, ok bool) {
+	if IsNil(err) {
+		return "", "", false
+	}
+
+	var re *reasonError
+	if As(err, &re) {
+		return re.domain, re.reason, true
+	}
+
+	return statusReason(err)
+}
@@ -0,0 +1,87 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWithReason(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("user not found")
+	err := errors.WithReason(errNotFound, "users.example.com", "USER_NOT_FOUND")
+
+	assertReason := func(t *testing.T, err error) {
+		t.Helper()
+
+		domain, reason, ok := errors.ReasonOf(err)
+		assert.True(t, ok)
+		assert.Equal(t, "users.example.com", domain)
+		assert.Equal(t, "USER_NOT_FOUND", reason)
+	}
+
+	t.Run("local", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, errors.WithReason(nil, "users.example.com", "USER_NOT_FOUND"))
+
+		_, _, ok := errors.ReasonOf(errNotFound)
+		assert.False(t, ok)
+
+		assertReason(t, errors.Wrap(err, "get"))
+		assertReason(t, errors.Clone(err))
+
+		domain, reason, _ := errors.ReasonOf(errors.WithReason(err, "api.example.com", "NOT_FOUND"))
+		assert.Equal(t, "api.example.com", domain, "the outermost reason wins")
+		assert.Equal(t, "NOT_FOUND", reason)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		st := toStatus(t, err, codes.NotFound)
+
+		var ei *errdetails.ErrorInfo
+
+		for _, d := range st.Details() {
+			if v, ok := d.(*errdetails.ErrorInfo); ok {
+				ei = v
+			}
+		}
+
+		require.NotNil(t, ei)
+		assert.Equal(t, "USER_NOT_FOUND", ei.GetReason())
+
+		dErr := fromStatus(t, st)
+		require.ErrorIs(t, dErr, errNotFound)
+		assertReason(t, dErr)
+
+		// Statuses built by other servers are read through their details.
+		st, sErr := status.New(codes.NotFound, "not found").
+			WithDetails(&errdetails.ErrorInfo{Domain: "users.example.com", Reason: "USER_NOT_FOUND"})
+		require.NoError(t, sErr)
+		assertReason(t, st.Err())
+	})
+
+	for _, codec := range codecs {
+		t.Run("codec "+codec, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(codec, err)
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(codec, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+			assertReason(t, dErr)
+		})
+	}
+}
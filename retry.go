@@ -0,0 +1,168 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+type retryError struct {
+	err       error
+	retryable bool
+}
+
+// Error implements the standard library error interface.
+func (re *retryError) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (re *retryError) Unwrap() error {
+	return re.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (re *retryError) Format(st fmt.State, verb rune) {
+	formatError(re, st, verb)
+}
+
+// MarkRetryable returns err marked as retryable, see IsRetryable.
+// If err is nil, MarkRetryable returns nil.
+func MarkRetryable(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &retryError{err: err, retryable: true}
+}
+
+// MarkPermanent returns err marked as not retryable, see IsRetryable.
+// If err is nil, MarkPermanent returns nil.
+func MarkPermanent(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &retryError{err: err, retryable: false}
+}
+
+// IsRetryable reports whether the operation failing with err can be retried.
+//
+// The outermost mark of the chain, see MarkRetryable and MarkPermanent, decides. Without marks,
+// canceled contexts are not retryable, while timeouts, see IsTimeout, transient network failures,
+// see IsNetwork, and errors reporting themselves as temporary, e.g. some net.Error, are.
+func IsRetryable(err error) bool {
+	if IsNil(err) {
+		return false
+	}
+
+	var (
+		marked    bool
+		retryable bool
+	)
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if re, ok := err.(*retryError); ok {
+			marked, retryable = true, re.retryable
+
+			return false
+		}
+
+		return true
+	})
+
+	if marked {
+		return retryable
+	}
+
+	if Is(err, context.Canceled) {
+		return false
+	}
+
+	if IsTimeout(err) || isNetworkBlip(err) {
+		return true
+	}
+
+	type temporary interface {
+		Temporary() bool
+	}
+
+	var t temporary
+
+	return As(err, &t) && t.Temporary()
+}
+
+// IsTimeout reports whether err is caused by a timeout: a context deadline, os.ErrDeadlineExceeded
+// or an error of the chain reporting itself as a timeout, e.g. a net.Error.
+func IsTimeout(err error) bool {
+	if IsNil(err) {
+		return false
+	}
+
+	if Is(err, context.DeadlineExceeded) || Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+
+	timeout := false
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if t, ok := err.(interface{ Timeout() bool }); ok && t.Timeout() {
+			timeout = true
+		}
+
+		return !timeout
+	})
+
+	return timeout
+}
+
+type retryAfterError struct {
+	err   error
+	after time.Duration
+}
+
+// Error implements the standard library error interface.
+func (re *retryAfterError) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (re *retryAfterError) Unwrap() error {
+	return re.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (re *retryAfterError) Format(st fmt.State, verb rune) {
+	formatError(re, st, verb)
+}
+
+// WithRetryAfter returns err carrying the delay after which the operation can be retried, see RetryAfter.
+//
+// The delay is sent to clients by ToStatus as an errdetails.RetryInfo detail and by the HTTP handlers
+// as a Retry-After header.
+// If err is nil, WithRetryAfter returns nil.
+func WithRetryAfter(err error, d time.Duration) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &retryAfterError{err: err, after: d}
+}
+
+// RetryAfter returns the delay after which the operation failing with err can be retried, and whether
+// err carries one: set by WithRetryAfter or received in the errdetails.RetryInfo detail of a grpc status.
+func RetryAfter(err error) (time.Duration, bool) {
+	if IsNil(err) {
+		return 0, false
+	}
+
+	var re *retryAfterError
+	if As(err, &re) {
+		return re.after, true
+	}
+
+	return statusRetryAfter(err)
+}
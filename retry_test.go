@@ -0,0 +1,152 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/dohernandez/errors"
+)
+
+type netError struct {
+	timeout   bool
+	temporary bool
+}
+
+func (e netError) Error() string   { return "net failure" }
+func (e netError) Timeout() bool   { return e.timeout }
+func (e netError) Temporary() bool { return e.temporary }
+
+var _ net.Error = netError{}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scenario  string
+		err       error
+		retryable bool
+		timeout   bool
+	}{
+		{scenario: "nil"},
+		{scenario: "plain", err: errors.New("failed")},
+		{scenario: "marked retryable", err: errors.Wrap(errors.MarkRetryable(errors.New("failed")), "read"), retryable: true},
+		{scenario: "marked permanent", err: errors.MarkPermanent(context.DeadlineExceeded), timeout: true},
+		{
+			scenario:  "outermost mark wins",
+			err:       errors.MarkRetryable(errors.Wrap(errors.MarkPermanent(errors.New("failed")), "read")),
+			retryable: true,
+		},
+		{scenario: "deadline", err: errors.WrapError(context.DeadlineExceeded, errors.New("read")), retryable: true, timeout: true},
+		{scenario: "os deadline", err: fmt.Errorf("read: %w", os.ErrDeadlineExceeded), retryable: true, timeout: true},
+		{scenario: "canceled", err: errors.Wrap(context.Canceled, "read")},
+		{scenario: "net timeout", err: errors.Wrap(netError{timeout: true}, "dial"), retryable: true, timeout: true},
+		{scenario: "net temporary", err: errors.Wrap(netError{temporary: true}, "dial"), retryable: true},
+		{scenario: "net permanent", err: errors.Wrap(netError{}, "dial")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.retryable, errors.IsRetryable(tc.err))
+			assert.Equal(t, tc.timeout, errors.IsTimeout(tc.err))
+		})
+	}
+}
+
+func TestMarkRetryable(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, errors.MarkRetryable(nil))
+	assert.NoError(t, errors.MarkPermanent(nil))
+
+	err := errors.New("failed")
+
+	assert.EqualError(t, errors.MarkRetryable(err), "failed")
+	assert.ErrorIs(t, errors.MarkPermanent(err), err)
+	assert.True(t, errors.IsRetryable(errors.Clone(errors.MarkRetryable(err))))
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	err := errors.WithRetryAfter(errors.WithCode(errors.New("overloaded"), codes.Unavailable), 1500*time.Millisecond)
+
+	t.Run("local", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, errors.WithRetryAfter(nil, time.Second))
+
+		d, ok := errors.RetryAfter(errors.Wrap(err, "call"))
+		assert.True(t, ok)
+		assert.Equal(t, 1500*time.Millisecond, d)
+
+		_, ok = errors.RetryAfter(errors.New("overloaded"))
+		assert.False(t, ok)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		st := toStatus(t, err, codes.Unavailable)
+
+		d, ok := errors.RetryAfter(fromStatus(t, st))
+		assert.True(t, ok)
+		assert.Equal(t, 1500*time.Millisecond, d)
+
+		// Statuses built by other servers are read through their details.
+		st, sErr := status.New(codes.Unavailable, "overloaded").
+			WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+		require.NoError(t, sErr)
+
+		d, ok = errors.RetryAfter(fmt.Errorf("call: %w", st.Err()))
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, d)
+	})
+
+	for _, name := range codecs {
+		t.Run("codec "+name, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(name, err)
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(name, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+
+			d, ok := errors.RetryAfter(dErr)
+			assert.True(t, ok)
+			assert.Equal(t, 1500*time.Millisecond, d)
+		})
+	}
+
+	t.Run("http", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+
+		handler(t, func(http.ResponseWriter, *http.Request) error {
+			return err
+		}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	})
+}
@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+)
+
+// RedactedValue is the representation of the Secret values.
+const RedactedValue = "[REDACTED]"
+
+// Secret is a field value rendered as RedactedValue, e.g. a token or a password, see Redact.
+//
+// It is printed, marshaled to JSON, logged and sent in the status details as RedactedValue,
+// the actual value is only available with Reveal.
+type Secret struct {
+	value any
+}
+
+// Redact returns v as a Secret, to pass it to Enrich and similar functions without leaking it.
+//
+//	errors.Enrich(err, "token", errors.Redact(token))
+func Redact(v any) Secret {
+	return Secret{value: v}
+}
+
+// Reveal returns the actual value, meant for trusted contexts only.
+func (s Secret) Reveal() any {
+	return s.value
+}
+
+// String implements fmt.Stringer.
+func (s Secret) String() string {
+	return RedactedValue
+}
+
+// GoString implements fmt.GoStringer, so %#v does not print the value either.
+func (s Secret) GoString() string {
+	return RedactedValue
+}
+
+// Format implements fmt.Formatter, all verbs print RedactedValue.
+func (s Secret) Format(st fmt.State, verb rune) {
+	if verb == 'q' {
+		_, _ = st.Write([]byte(strconv.Quote(RedactedValue)))
+
+		return
+	}
+
+	_, _ = st.Write([]byte(RedactedValue))
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(RedactedValue)), nil
+}
+
+// LogValue implements slog.LogValuer.
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(RedactedValue)
+}
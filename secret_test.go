@@ -0,0 +1,75 @@
+package errors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	const token = "s3cr3t"
+
+	err := errors.Enrich(errors.New("unauthorized"), "user", "john", "token", errors.Redact(token))
+
+	t.Run("format", func(t *testing.T) {
+		t.Parallel()
+
+		secret := errors.Redact(token)
+
+		for _, s := range []string{
+			fmt.Sprint(secret), fmt.Sprintf("%v", secret), fmt.Sprintf("%+v", secret),
+			fmt.Sprintf("%#v", secret), fmt.Sprintf("%s", secret), //nolint:gosimple
+		} {
+			assert.Equal(t, errors.RedactedValue, s)
+		}
+
+		assert.Equal(t, `"[REDACTED]"`, fmt.Sprintf("%q", secret))
+		assert.NotContains(t, fmt.Sprintf("%+v", err), token)
+	})
+
+	t.Run("reveal", func(t *testing.T) {
+		t.Parallel()
+
+		secret, ok := errors.Fields(err)["token"].(errors.Secret)
+		require.True(t, ok, "field is not a secret")
+		assert.Equal(t, token, secret.Reveal())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		b, mErr := json.Marshal(errors.NewEnvelope(err))
+		require.NoError(t, mErr)
+		assert.NotContains(t, string(b), token)
+		assert.Contains(t, string(b), errors.RedactedValue)
+	})
+
+	t.Run("slog", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		slog.New(slog.NewJSONHandler(&buf, nil)).Error("failed", "err", slog.GroupValue(errors.SlogAttrs(err)...))
+
+		assert.NotContains(t, buf.String(), token)
+		assert.Contains(t, buf.String(), errors.RedactedValue)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		sErr := fromStatus(t, toStatus(t, err, codes.Unauthenticated))
+
+		assert.Equal(t, map[string]interface{}{"user": "john", "token": errors.RedactedValue}, errors.Fields(sErr))
+	})
+}
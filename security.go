@@ -0,0 +1,132 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SecurityEventVendor, SecurityEventProduct and SecurityEventVersion identify the device in the headers
+// of the security events rendered by CEF and LEEF, set them at init time.
+var (
+	SecurityEventVendor  = "dohernandez"
+	SecurityEventProduct = "errors"
+	SecurityEventVersion = "1"
+)
+
+// cefSeverity is the CEF severity of the security events, medium on the 0 to 10 scale.
+const cefSeverity = 5
+
+var (
+	eventHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefValueEscaper    = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+	leefValueEscaper   = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+)
+
+// IsSecurityEvent tells whether err is relevant to security, i.e. its kind is KindUnauthenticated
+// or KindPermissionDenied, see KindOf.
+func IsSecurityEvent(err error) bool {
+	switch KindOf(err) {
+	case KindUnauthenticated, KindPermissionDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+// CEF renders the security event of err as an ArcSight Common Event Format line, with the kind as
+// signature ID, the message as name, and the reason, see WithReason, and the fields of the chain
+// as extensions, e.g.
+//
+//	CEF:0|dohernandez|errors|1|permission_denied|delete user: forbidden|5|reason=NOT_OWNER user=5
+//
+// If err is not a security event, see IsSecurityEvent, CEF returns an empty string.
+func CEF(err error, opts ...Option) string {
+	if !IsSecurityEvent(err) {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("CEF:0|")
+	writeEventHeader(&sb, SecurityEventVendor, SecurityEventProduct, SecurityEventVersion,
+		string(KindOf(err)), err.Error(), fmt.Sprint(cefSeverity))
+
+	for i, kv := 0, securityEventTuples(err, opts); i+1 < len(kv); i += 2 {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+
+		sb.WriteString(eventKey(fmt.Sprint(kv[i])))
+		sb.WriteString("=")
+		sb.WriteString(cefValueEscaper.Replace(fmt.Sprint(kv[i+1])))
+	}
+
+	return sb.String()
+}
+
+// LEEF renders the security event of err as an IBM QRadar Log Event Extended Format 1.0 line, with the kind
+// as event ID, and the message, the reason, see WithReason, and the fields of the chain as tab separated
+// attributes, e.g.
+//
+//	LEEF:1.0|dohernandez|errors|1|permission_denied|msg=delete user: forbidden	reason=NOT_OWNER	user=5
+//
+// If err is not a security event, see IsSecurityEvent, LEEF returns an empty string.
+func LEEF(err error, opts ...Option) string {
+	if !IsSecurityEvent(err) {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("LEEF:1.0|")
+	writeEventHeader(&sb, SecurityEventVendor, SecurityEventProduct, SecurityEventVersion, string(KindOf(err)))
+
+	sb.WriteString("msg=")
+	sb.WriteString(leefValueEscaper.Replace(err.Error()))
+
+	for i, kv := 0, securityEventTuples(err, opts); i+1 < len(kv); i += 2 {
+		sb.WriteString("\t")
+		sb.WriteString(eventKey(fmt.Sprint(kv[i])))
+		sb.WriteString("=")
+		sb.WriteString(leefValueEscaper.Replace(fmt.Sprint(kv[i+1])))
+	}
+
+	return sb.String()
+}
+
+// writeEventHeader writes the escaped header fields, each followed by a pipe.
+func writeEventHeader(sb *strings.Builder, fields ...string) {
+	for _, f := range fields {
+		sb.WriteString(eventHeaderEscaper.Replace(f))
+		sb.WriteString("|")
+	}
+}
+
+// securityEventTuples returns the reason of err, if any, followed by the fields of the chain.
+func securityEventTuples(err error, opts []Option) []interface{} {
+	o := newOptions(opts)
+	kv := o.merge(o.tuples(keysAndValues(err)))
+
+	if _, reason, ok := ReasonOf(err); ok {
+		return append([]interface{}{"reason", reason}, kv...)
+	}
+
+	return kv
+}
+
+// eventKey makes name a valid extension key, made of ASCII letters, digits and underscores.
+func eventKey(name string) string {
+	b := []byte(name)
+
+	for i, c := range b {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			b[i] = '_'
+		}
+	}
+
+	if len(b) == 0 {
+		return "_"
+	}
+
+	return string(b)
+}
@@ -0,0 +1,68 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestCEF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a security event", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, errors.CEF(nil))
+		assert.Empty(t, errors.CEF(errors.WithKind(errors.New("no rows"), errors.KindNotFound)))
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithReason(
+			errors.WithKind(errors.Enrich(errors.New("forbidden"), "user id", 5, "path", `c:\a=b`), errors.KindPermissionDenied),
+			"example.com", "NOT_OWNER",
+		)
+		err = errors.Wrap(err, "delete | user")
+
+		require.Equal(t,
+			`CEF:0|dohernandez|errors|1|permission_denied|delete \| user: forbidden|5|reason=NOT_OWNER user_id=5 path=c:\\a\=b`,
+			errors.CEF(err),
+		)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "CEF:0|dohernandez|errors|1|unauthenticated|token expired|5|",
+			errors.CEF(errors.WithKind(errors.New("token expired"), errors.KindUnauthenticated)))
+	})
+}
+
+func TestLEEF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a security event", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, errors.LEEF(nil))
+		assert.Empty(t, errors.LEEF(errors.New("failed")))
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithReason(
+			errors.WithKind(errors.Enrich(errors.New("forbidden"), "user id", 5, "note", "a\tb"), errors.KindPermissionDenied),
+			"example.com", "NOT_OWNER",
+		)
+
+		require.Equal(t,
+			"LEEF:1.0|dohernandez|errors|1|permission_denied|msg=forbidden\treason=NOT_OWNER\tuser_id=5\tnote=a\\tb",
+			errors.LEEF(err),
+		)
+	})
+}
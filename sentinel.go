@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"fmt"
+	"sync"
+)
+
+type sentinelError struct {
+	name    string
+	message string
+}
+
+// Error implements the standard library error interface.
+func (se *sentinelError) Error() string {
+	return se.message
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (se *sentinelError) Format(st fmt.State, verb rune) {
+	formatError(se, st, verb)
+}
+
+var sentinels = struct {
+	sync.RWMutex
+
+	byName map[string]*sentinelError
+}{
+	byName: map[string]*sentinelError{},
+}
+
+// NewSentinel returns a sentinel error with the message, registered under the unique name,
+// e.g. "users.NotFound".
+//
+// Unlike the errors created by New, which match any error with the same message, a sentinel only
+// matches itself: Is compares identity. The name is serialized with the error, e.g. by ToStatus,
+// so the sentinel recreated on the other side, e.g. by FromStatus, is the registered one.
+//
+// It is meant to be called to declare package variables, it panics if the name is already registered.
+func NewSentinel(name, message string) error {
+	sentinels.Lock()
+	defer sentinels.Unlock()
+
+	if _, ok := sentinels.byName[name]; ok {
+		panic("errors: sentinel " + name + " already registered")
+	}
+
+	s := &sentinelError{name: name, message: message}
+	sentinels.byName[name] = s
+
+	return s
+}
+
+// sentinel returns the sentinel registered under name.
+func sentinel(name string) (*sentinelError, bool) {
+	sentinels.RLock()
+	defer sentinels.RUnlock()
+
+	s, ok := sentinels.byName[name]
+
+	return s, ok
+}
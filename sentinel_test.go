@@ -0,0 +1,71 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+var errUserNotFound = errors.NewSentinel("errors_test.UserNotFound", "not found")
+
+func TestNewSentinel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identity", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WrapError(errors.New("no rows"), errUserNotFound)
+		require.EqualError(t, err, "not found: no rows")
+		require.ErrorIs(t, err, errUserNotFound)
+
+		require.NotErrorIs(t, errors.New("not found"), errUserNotFound, "same message, other error")
+		require.NotErrorIs(t, errUserNotFound, errors.New("not found"), "same message, other error")
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() {
+			_ = errors.NewSentinel("errors_test.UserNotFound", "user not found")
+		})
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		err := fromStatus(t, toStatus(t, errors.WrapError(errors.New("no rows"), errUserNotFound), codes.NotFound))
+		require.EqualError(t, err, "not found: no rows")
+		require.ErrorIs(t, err, errUserNotFound)
+	})
+
+	for _, codec := range codecs {
+		t.Run("codec "+codec, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(codec, errors.Wrap(errUserNotFound, "get"))
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(codec, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+			require.ErrorIs(t, dErr, errUserNotFound)
+		})
+	}
+
+	t.Run("not registered", func(t *testing.T) {
+		t.Parallel()
+
+		env := &errors.Envelope{
+			Message: "gone",
+			Chain:   &errors.Link{Type: "sentinel", Message: "gone", Name: "unknown.Gone"},
+		}
+
+		dErr := env.Err()
+		require.EqualError(t, dErr, "gone")
+	})
+}
@@ -0,0 +1,131 @@
+package errors
+
+import "context"
+
+// ServerOption configures the gRPC server interceptors and the HTTP handlers of the package.
+type ServerOption func(o *serverOptions)
+
+type serverOptions struct {
+	pipeline          Pipeline
+	passThroughStatus bool
+	envelopeOptions   []Option
+	negotiate         bool
+	baggageKeys       []string
+	policy            Policy
+	problemFields     bool
+}
+
+func newServerOptions(opts []ServerOption) *serverOptions {
+	o := &serverOptions{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithPipeline processes the Envelope of the errors with p before they are encoded.
+func WithPipeline(p Pipeline) ServerOption {
+	return func(o *serverOptions) {
+		o.pipeline = p
+	}
+}
+
+// PassThroughStatusErrors forwards untouched the status errors returned by gRPC handlers, e.g. built with
+// status.Error or received from an upstream service, instead of converting them.
+//
+// Only errors implementing GRPCStatus themselves are forwarded, status errors wrapped by other errors are converted.
+func PassThroughStatusErrors() ServerOption {
+	return func(o *serverOptions) {
+		o.passThroughStatus = true
+	}
+}
+
+// NegotiateCapabilities tailors the status errors to the capabilities advertised by the client in the request
+// metadata, see CapabilitiesMetadataKey: the error chain detail is only sent to clients understanding it,
+// e.g. using UnaryClientInterceptor, other clients get the message and the standard errdetails.
+//
+// Without it, the error chain detail is always sent.
+func NegotiateCapabilities() ServerOption {
+	return func(o *serverOptions) {
+		o.negotiate = true
+	}
+}
+
+// WithProblemFields exposes the fields of the client errors, resolved to a 4xx status, as extensions of the
+// problem details responses written by the HTTP handlers, see AllowFields to only expose some of them.
+//
+// Without it, the fields are internal and never exposed, like the fields of the server errors.
+func WithProblemFields() ServerOption {
+	return func(o *serverOptions) {
+		o.problemFields = true
+	}
+}
+
+// WithEnvelopeOptions creates the Envelope of the errors with opts, e.g. WithAnonymizedFields or WithDebugDetails.
+func WithEnvelopeOptions(opts ...Option) ServerOption {
+	return func(o *serverOptions) {
+		o.envelopeOptions = append(o.envelopeOptions, opts...)
+	}
+}
+
+// Policy tailors the errors sent by the server interceptors and the HTTP handlers to the request,
+// e.g. per tenant on multi-tenant platforms, see WithPolicy.
+type Policy interface {
+	// EnvelopeOptions returns the options the Envelope of the errors of the request of ctx is created with,
+	// after the ones of WithEnvelopeOptions, e.g. WithDebugDetails for internal tenants only.
+	EnvelopeOptions(ctx context.Context) []Option
+	// Process processes the Envelope of the errors of the request of ctx after the Pipeline, e.g. to replace
+	// the message shown to the users or keep only the allowed fields, see AllowFields.
+	// Returning nil drops the error details.
+	Process(ctx context.Context, e *Envelope) *Envelope
+}
+
+// PolicyFuncs is a Policy made of functions, the nil ones being skipped.
+type PolicyFuncs struct {
+	EnvelopeOptionsFunc func(ctx context.Context) []Option
+	ProcessFunc         func(ctx context.Context, e *Envelope) *Envelope
+}
+
+// EnvelopeOptions implements Policy.
+func (p PolicyFuncs) EnvelopeOptions(ctx context.Context) []Option {
+	if p.EnvelopeOptionsFunc == nil {
+		return nil
+	}
+
+	return p.EnvelopeOptionsFunc(ctx)
+}
+
+// Process implements Policy.
+func (p PolicyFuncs) Process(ctx context.Context, e *Envelope) *Envelope {
+	if p.ProcessFunc == nil {
+		return e
+	}
+
+	return p.ProcessFunc(ctx, e)
+}
+
+// WithPolicy consults p with the request context to create and process the Envelope of the errors.
+func WithPolicy(p Policy) ServerOption {
+	return func(o *serverOptions) {
+		o.policy = p
+	}
+}
+
+// envelope returns the processed envelope of err for the request of ctx.
+func (o *serverOptions) envelope(ctx context.Context, err error) *Envelope {
+	opts := o.envelopeOptions
+
+	if o.policy != nil {
+		opts = append(opts[:len(opts):len(opts)], o.policy.EnvelopeOptions(ctx)...)
+	}
+
+	e := o.pipeline.Process(NewEnvelope(err, opts...))
+
+	if o.policy != nil && e != nil {
+		e = o.policy.Process(ctx, e)
+	}
+
+	return e
+}
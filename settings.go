@@ -0,0 +1,50 @@
+package errors
+
+import "sync/atomic"
+
+// Settings are runtime switches of the behaviors of the package that are expensive or sensitive,
+// so operators can toggle them during incidents without redeploying, see SetSettings.
+type Settings struct {
+	// EmitStacks enables the capture of stack traces, see EnableStackTrace.
+	EmitStacks bool
+	// EmitDebugInfo serializes the stack traces by default, see WithDebugDetails.
+	EmitDebugInfo bool
+	// MaxDetailBytes bounds the messages of the envelopes created by NewEnvelope, zero means unbounded.
+	MaxDetailBytes int
+	// MalformedFieldsHook, when set, is called with the key-value pairs of odd length given to Enrich and similar
+	// functions, e.g. to fail tests or log the misuse in a strict mode, see MalformedFieldsKey.
+	MalformedFieldsHook func(keysAndValues []interface{})
+}
+
+// settings is initialized along the package variables, the sentinel errors are created with it.
+var settings = func() *atomic.Pointer[Settings] {
+	var p atomic.Pointer[Settings]
+
+	p.Store(&Settings{})
+
+	return &p
+}()
+
+// SetSettings atomically replaces the Settings of the package.
+func SetSettings(s Settings) {
+	settings.Store(&s)
+}
+
+// CurrentSettings returns the Settings of the package.
+func CurrentSettings() Settings {
+	return *settings.Load()
+}
+
+// UpdateSettings atomically applies fn to the Settings of the package, without losing concurrent updates.
+func UpdateSettings(fn func(s *Settings)) {
+	for {
+		old := settings.Load()
+
+		s := *old
+		fn(&s)
+
+		if settings.CompareAndSwap(old, &s) {
+			return
+		}
+	}
+}
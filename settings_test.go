@@ -0,0 +1,88 @@
+package errors_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+//nolint:paralleltest // Changes the settings.
+func TestSetSettings(t *testing.T) {
+	defer errors.SetSettings(errors.CurrentSettings())
+
+	errors.SetSettings(errors.Settings{EmitStacks: true, EmitDebugInfo: true, MaxDetailBytes: 16})
+
+	err := errors.Wrap(errors.New("failed"), strings.Repeat("a", 32))
+
+	require.NotEmpty(t, errors.StackTrace(err), "stack trace is captured")
+
+	env := errors.NewEnvelope(err)
+	require.LessOrEqual(t, len(env.Message), 16)
+	require.LessOrEqual(t, len(env.Chain.Message), 16)
+	require.NotEmpty(t, env.StackEntries)
+
+	t.Run("status", func(t *testing.T) {
+		require.NotEmpty(t, errors.RemoteStackTrace(toStatus(t, err, codes.Internal).Err()))
+		require.Empty(t, errors.RemoteStackTrace(toStatus(t, err, codes.Internal, errors.WithDebugDetails(false)).Err()),
+			"options take precedence over the settings")
+	})
+
+	errors.EnableStackTrace(false)
+	assert.False(t, errors.CurrentSettings().EmitStacks)
+	assert.Equal(t, 16, errors.CurrentSettings().MaxDetailBytes, "other settings are kept")
+}
+
+//nolint:paralleltest // Changes the settings.
+func TestUpdateSettings(t *testing.T) {
+	defer errors.SetSettings(errors.CurrentSettings())
+
+	errors.SetSettings(errors.Settings{})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errors.UpdateSettings(func(s *errors.Settings) {
+				s.MaxDetailBytes++
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 100, errors.CurrentSettings().MaxDetailBytes)
+}
+
+//nolint:paralleltest // Changes the settings.
+func TestSettings_MalformedFieldsHook(t *testing.T) {
+	defer errors.SetSettings(errors.CurrentSettings())
+
+	var reported []interface{}
+
+	errors.UpdateSettings(func(s *errors.Settings) {
+		s.MalformedFieldsHook = func(keysAndValues []interface{}) {
+			reported = keysAndValues
+		}
+	})
+
+	err := errors.Enrich(errors.New("failed"), "id", 5)
+	require.Nil(t, reported, "well-formed pairs are not reported")
+
+	err = errors.Enrich(err, "op", "get", "orphan")
+	require.Equal(t, []interface{}{"op", "get", "orphan"}, reported)
+	assert.Equal(t, map[string]interface{}{
+		"id":                      5,
+		"op":                      "get",
+		errors.MalformedFieldsKey: []interface{}{"orphan"},
+	}, errors.Fields(err))
+}
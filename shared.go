@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+)
+
+type sharedError struct {
+	err error
+	// caller is the call point of MarkShared.
+	caller stack
+}
+
+// Error implements the standard library error interface.
+func (se *sharedError) Error() string {
+	return se.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (se *sharedError) Unwrap() error {
+	return se.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (se *sharedError) Format(st fmt.State, verb rune) {
+	formatError(se, st, verb)
+}
+
+// MarkShared returns a wrapper around err, shared between several callers, annotated with the point
+// MarkShared is called.
+//
+// It is meant for singleflight-style deduplication layers: each waiter receives its own wrapper,
+// which can be further wrapped or enriched, instead of aliasing the exact same error value.
+// If err is nil, MarkShared returns nil.
+func MarkShared(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	var pc [1]uintptr
+
+	// Skip runtime.Callers and MarkShared.
+	n := runtime.Callers(2, pc[:])
+
+	return &sharedError{
+		err:    err,
+		caller: pc[:n:n],
+	}
+}
+
+// IsShared reports whether err was received from a deduplication layer, see MarkShared.
+func IsShared(err error) bool {
+	var se *sharedError
+
+	return As(err, &se)
+}
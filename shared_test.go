@@ -0,0 +1,34 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestMarkShared(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	require.NoError(t, errors.MarkShared(nil))
+	require.False(t, errors.IsShared(errFailed))
+
+	err1 := errors.MarkShared(errFailed)
+	err2 := errors.MarkShared(errFailed)
+
+	require.NotSame(t, err1, err2)
+
+	for _, err := range []error{err1, err2} {
+		require.EqualError(t, err, "failed")
+		require.ErrorIs(t, err, errFailed)
+		require.True(t, errors.IsShared(errors.Wrap(err, "oops")))
+	}
+
+	verbose := fmt.Sprintf("%+v", err1)
+	require.True(t, strings.HasPrefix(verbose, "failed\ngithub.com/dohernandez/errors_test.TestMarkShared\n"), verbose)
+}
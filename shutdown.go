@@ -0,0 +1,31 @@
+package errors
+
+import "context"
+
+// ErrShuttingDown is the sentinel of failures induced by a graceful shutdown.
+var ErrShuttingDown = NewSentinel("errors.ShuttingDown", "shutting down")
+
+// MarkShuttingDown returns err marked as induced by a graceful shutdown, see IsShuttingDown.
+//
+// If err is nil, MarkShuttingDown returns nil.
+func MarkShuttingDown(err error) error {
+	if IsNil(err) || IsShuttingDown(err) {
+		return err
+	}
+
+	return WrapError(err, ErrShuttingDown)
+}
+
+// IsShuttingDown reports whether err was induced by a graceful shutdown,
+// so log pipelines can separate shutdown noise from real failures.
+func IsShuttingDown(err error) bool {
+	return Is(err, ErrShuttingDown)
+}
+
+// CancelShuttingDown cancels a context with ErrShuttingDown as its cause.
+//
+// Servers call it on the contexts of in-flight work when draining, errors built from those contexts
+// with FromContext or WrapCtx are then reported by IsShuttingDown.
+func CancelShuttingDown(cancel context.CancelCauseFunc) {
+	cancel(ErrShuttingDown)
+}
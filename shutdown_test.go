@@ -0,0 +1,36 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestMarkShuttingDown(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	err := errors.MarkShuttingDown(errFailed)
+	require.EqualError(t, err, "shutting down: failed")
+	require.ErrorIs(t, err, errFailed)
+	require.True(t, errors.IsShuttingDown(err))
+
+	require.Equal(t, err, errors.MarkShuttingDown(err))
+	require.NoError(t, errors.MarkShuttingDown(nil))
+	require.False(t, errors.IsShuttingDown(errFailed))
+	require.False(t, errors.IsShuttingDown(errors.New("shutting down")), "only the sentinel matches")
+}
+
+func TestCancelShuttingDown(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	errors.CancelShuttingDown(cancel)
+
+	require.True(t, errors.IsShuttingDown(errors.FromContext(ctx)))
+	require.True(t, errors.IsShuttingDown(errors.WrapCtx(ctx, errors.New("failed"), "oops")))
+}
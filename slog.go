@@ -0,0 +1,233 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// SlogAttrs returns the structured attributes of err: its message, the key-value pairs of the chain
+// and, when err wraps other errors, a "chain" group with the message of every error of the chain.
+//
+//	logger.LogAttrs(ctx, slog.LevelError, "failed", slog.Attr{Key: "err", Value: slog.GroupValue(errors.SlogAttrs(err)...)})
+//
+// The attributes depend on the Verbosity, see WithVerbosity: none when off, only the outermost message
+// when brief, and with VerbosityFull a "trace" attribute holding the chain with stack traces as printed by %+v.
+func SlogAttrs(err error, opts ...Option) []slog.Attr {
+	if IsNil(err) {
+		return nil
+	}
+
+	o := newOptions(opts)
+	v := o.currentVerbosity()
+
+	switch v {
+	case VerbosityOff:
+		return nil
+	case VerbosityBrief:
+		return []slog.Attr{slog.String("message", Brief(err))}
+	}
+
+	kv := o.merge(o.tuples(keysAndValues(err)))
+	attrs := make([]slog.Attr, 0, 2+len(kv)/2)
+
+	attrs = append(attrs, slog.String("message", err.Error()))
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		attrs = append(attrs, slog.Any(fmt.Sprint(kv[i]), kv[i+1]))
+	}
+
+	var (
+		chain []slog.Attr
+		last  string
+	)
+
+	walk(err, func(err error) bool {
+		// Wrappers that do not change the message, e.g. enrichedError, are skipped.
+		if msg := message(err); len(chain) == 0 || msg != last {
+			chain = append(chain, slog.String(strconv.Itoa(len(chain)), msg))
+			last = msg
+		}
+
+		return true
+	})
+
+	if len(chain) > 1 {
+		attrs = append(attrs, slog.Attr{Key: "chain", Value: slog.GroupValue(chain...)})
+	}
+
+	if v == VerbosityFull {
+		var sb strings.Builder
+
+		writeVerbose(&sb, err)
+
+		attrs = append(attrs, slog.String("trace", sb.String()))
+	}
+
+	return attrs
+}
+
+// SlogHandler returns a slog.Handler logging the error attributes with the options, e.g. WithVerbosity,
+// before passing the records to h.
+//
+// It sets the verbosity per logger, e.g. full to a file sink and brief to stdout:
+//
+//	file := slog.New(errors.SlogHandler(fileHandler, errors.WithVerbosity(errors.VerbosityFull)))
+func SlogHandler(h slog.Handler, opts ...Option) slog.Handler {
+	return slogHandler{Handler: h, opts: opts}
+}
+
+type slogHandler struct {
+	slog.Handler
+
+	opts []Option
+}
+
+// Handle implements slog.Handler.
+func (h slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	nr := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+
+	r.Attrs(func(a slog.Attr) bool {
+		nr.AddAttrs(h.attr(a))
+
+		return true
+	})
+
+	return h.Handler.Handle(ctx, nr)
+}
+
+// WithAttrs implements slog.Handler.
+func (h slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	replaced := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		replaced[i] = h.attr(a)
+	}
+
+	return slogHandler{Handler: h.Handler.WithAttrs(replaced), opts: h.opts}
+}
+
+// WithGroup implements slog.Handler.
+func (h slogHandler) WithGroup(name string) slog.Handler {
+	return slogHandler{Handler: h.Handler.WithGroup(name), opts: h.opts}
+}
+
+// attr returns a with the attributes of its error value, a if it has none.
+func (h slogHandler) attr(a slog.Attr) slog.Attr {
+	if k := a.Value.Kind(); k != slog.KindAny && k != slog.KindLogValuer {
+		return a
+	}
+
+	err, ok := a.Value.Any().(error)
+	if !ok {
+		return a
+	}
+
+	return slog.Attr{Key: a.Key, Value: logValue(err, h.opts...)}
+}
+
+// logValue returns the slog.Value of err, its message when there are no other attributes.
+func logValue(err error, opts ...Option) slog.Value {
+	attrs := SlogAttrs(err, opts...)
+	if len(attrs) == 1 {
+		return attrs[0].Value
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer.
+func (s *errorString) LogValue() slog.Value {
+	return logValue(s)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (wm *withMessage) LogValue() slog.Value {
+	return logValue(wm)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (we *withError) LogValue() slog.Value {
+	return logValue(we)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (ee *enrichedError) LogValue() slog.Value {
+	return logValue(ee)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (wc *withCode) LogValue() slog.Value {
+	return logValue(wc)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (ws *withHTTPStatus) LogValue() slog.Value {
+	return logValue(ws)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (fe *frozenError) LogValue() slog.Value {
+	return logValue(fe)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (me *MultiError) LogValue() slog.Value {
+	return logValue(me)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (ke *kindError) LogValue() slog.Value {
+	return logValue(ke)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (le *localizedError) LogValue() slog.Value {
+	return logValue(le)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (oe *opError) LogValue() slog.Value {
+	return logValue(oe)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (pe *panicError) LogValue() slog.Value {
+	return logValue(pe)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (re *reasonError) LogValue() slog.Value {
+	return logValue(re)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (re *retryError) LogValue() slog.Value {
+	return logValue(re)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (re *retryAfterError) LogValue() slog.Value {
+	return logValue(re)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (se *sentinelError) LogValue() slog.Value {
+	return logValue(se)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (se *sharedError) LogValue() slog.Value {
+	return logValue(se)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (se *staleError) LogValue() slog.Value {
+	return logValue(se)
+}
+
+// LogValue implements slog.LogValuer, see SlogAttrs.
+func (ve *violationsError) LogValue() slog.Value {
+	return logValue(ve)
+}
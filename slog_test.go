@@ -0,0 +1,181 @@
+package errors_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestSlogAttrs(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, errors.SlogAttrs(nil))
+
+	require.Equal(t, []slog.Attr{slog.String("message", "failed")}, errors.SlogAttrs(errors.New("failed")))
+
+	err := errors.EnrichWrapError(errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "path", "/tmp"), errors.New("oops"), "id", 5)
+
+	require.Equal(t, []slog.Attr{
+		slog.String("message", "oops: read: failed"),
+		slog.Int("id", 5),
+		slog.String("path", "/tmp"),
+		slog.Group("chain",
+			slog.String("0", "oops: read: failed"),
+			slog.String("1", "oops"),
+			slog.String("2", "read: failed"),
+			slog.String("3", "failed"),
+		),
+	}, errors.SlogAttrs(err))
+}
+
+func TestLogValue(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}))
+
+	logger.Error("plain", "err", errors.New("failed"))
+	logger.Error("enriched", "err", errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5))
+
+	dec := json.NewDecoder(&buf)
+
+	var entry map[string]interface{}
+
+	require.NoError(t, dec.Decode(&entry))
+	require.Equal(t, map[string]interface{}{"level": "ERROR", "msg": "plain", "err": "failed"}, entry)
+
+	require.NoError(t, dec.Decode(&entry))
+	require.Equal(t, map[string]interface{}{
+		"level": "ERROR",
+		"msg":   "enriched",
+		"err": map[string]interface{}{
+			"message": "read: failed",
+			"id":      5.0,
+			"chain":   map[string]interface{}{"0": "read: failed", "1": "failed"},
+		},
+	}, entry)
+}
+
+func TestLogValue_wrappers(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.New("no rows"), "id", 5)
+
+	// logged returns the attributes the error is logged with, failing if it is logged as a plain message.
+	logged := func(t *testing.T, err error) map[string]interface{} {
+		t.Helper()
+
+		var buf bytes.Buffer
+
+		slog.New(slog.NewJSONHandler(&buf, nil)).Error("failed", "err", err)
+
+		var entry struct {
+			Err map[string]interface{} `json:"err"`
+		}
+
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry), buf.String())
+
+		return entry.Err
+	}
+
+	for name, wErr := range map[string]error{
+		"code":        errors.WithCode(err, codes.NotFound),
+		"http status": errors.WithHTTPStatus(err, http.StatusNotFound),
+		"kind":        errors.WithKind(err, errors.KindNotFound),
+		"reason":      errors.WithReason(err, "example.com", "NO_ROWS"),
+		"localized":   errors.WithLocalizedMessage(err, "en", "Not found"),
+		"op":          errors.WrapOp(err, "users.Get"),
+		"join":        errors.Join(err),
+		"frozen":      errors.Freeze(err),
+		"shared":      errors.MarkShared(err),
+		"stale":       errors.WithStaleness(err, time.Now()),
+		"retryable":   errors.MarkRetryable(err),
+		"retry after": errors.WithRetryAfter(err, time.Second),
+		"violations":  errors.FieldViolations(err, errors.FieldViolation{Field: "id"}),
+		"panic":       errors.Recover(err),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, 5.0, logged(t, wErr)["id"])
+		})
+	}
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		cErr := unaryClientInterceptor(t)(context.Background(), "/test.Service/Method", "req", nil, nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				return toStatus(t, err, codes.NotFound).Err()
+			},
+		)
+
+		assert.Equal(t, 5.0, logged(t, cErr)["id"])
+	})
+}
+
+func TestSlogAttrs_verbosity(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5)
+
+	require.Nil(t, errors.SlogAttrs(err, errors.WithVerbosity(errors.VerbosityOff)))
+	require.Equal(t, []slog.Attr{slog.String("message", "read")},
+		errors.SlogAttrs(err, errors.WithVerbosity(errors.VerbosityBrief)))
+
+	attrs := errors.SlogAttrs(err, errors.WithVerbosity(errors.VerbosityFull))
+	require.Len(t, attrs, 4)
+	require.Equal(t, slog.String("trace", fmt.Sprintf("%+v", err)), attrs[3])
+}
+
+func TestSlogHandler(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(errors.SlogHandler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	}), errors.WithVerbosity(errors.VerbosityBrief)))
+
+	err := errors.Enrich(errors.Wrap(errors.New("failed"), "read"), "id", 5)
+
+	logger.With("cause", err).Error("enriched", "err", err, "id", 5)
+
+	var entry map[string]interface{}
+
+	require.NoError(t, json.NewDecoder(&buf).Decode(&entry))
+	require.Equal(t, map[string]interface{}{
+		"level": "ERROR",
+		"msg":   "enriched",
+		"cause": "read",
+		"err":   "read",
+		"id":    5.0,
+	}, entry)
+}
@@ -0,0 +1,126 @@
+package errors
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// stackDepth is the maximum number of frames captured.
+const stackDepth = 32
+
+// EnableStackTrace sets whether New, Newf, Wrap, Wrapf, WrapError and EnrichWrapError capture the stack trace
+// at the point they are called. Capture is disabled by default, unless the Verbosity is VerbosityFull.
+//
+// It sets Settings.EmitStacks.
+func EnableStackTrace(enabled bool) {
+	UpdateSettings(func(s *Settings) {
+		s.EmitStacks = enabled
+	})
+}
+
+// stack is a stack trace as program counters.
+type stack []uintptr
+
+// callers returns the stack trace of the caller of the function calling callers, nil if capture is disabled.
+func callers() stack {
+	if !settings.Load().EmitStacks && CurrentVerbosity() != VerbosityFull {
+		return nil
+	}
+
+	// Skip runtime.Callers, captureStack, callers and the constructor.
+	return captureStack(4)
+}
+
+// captureStack returns the stack trace skipping skip frames, regardless of whether capture is enabled,
+// nil in TestMode.
+func captureStack(skip int) stack {
+	if testMode.Load() {
+		return nil
+	}
+
+	var pcs [stackDepth]uintptr
+
+	n := runtime.Callers(skip, pcs[:])
+
+	return append(stack(nil), pcs[:n]...)
+}
+
+// frames returns the frames of the stack trace.
+func (s stack) frames() []runtime.Frame {
+	if len(s) == 0 {
+		return nil
+	}
+
+	frames := make([]runtime.Frame, 0, len(s))
+	it := runtime.CallersFrames(s)
+
+	for {
+		f, more := it.Next()
+		frames = append(frames, f)
+
+		if !more {
+			return frames
+		}
+	}
+}
+
+// stackOf returns the stack trace captured by err itself.
+func stackOf(err error) stack {
+	//nolint:errorlint
+	switch e := err.(type) {
+	case *errorString:
+		return e.stack
+	case *withMessage:
+		return e.stack
+	case *withError:
+		return e.stack
+	case *sharedError:
+		return e.caller
+	default:
+		return nil
+	}
+}
+
+// StackTrace returns the frames of the deepest stack trace captured in the chain of err,
+// following the cause before the wrapped error, nil if none was captured.
+func StackTrace(err error) []runtime.Frame {
+	var st stack
+
+	for err != nil {
+		if s := stackOf(err); s != nil {
+			st = s
+		}
+
+		next := Cause(err)
+		if next == nil {
+			next = Unwrap(err)
+		}
+
+		err = next
+	}
+
+	return st.frames()
+}
+
+// writeFrames writes the frames of s, one function and file:line per frame.
+func writeFrames(sb *strings.Builder, s stack) {
+	for _, f := range s.frames() {
+		sb.WriteString("\n")
+		sb.WriteString(f.Function)
+		sb.WriteString("\n\t")
+		sb.WriteString(f.File)
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(f.Line))
+	}
+}
+
+// RemoteStackTrace returns the stack trace entries sent by the server in the errdetails.DebugInfo detail
+// of the grpc status carried by err, see WithDebugDetails. It returns nil if there are none.
+func RemoteStackTrace(err error) []string {
+	if IsNil(err) {
+		return nil
+	}
+
+	return statusStackEntries(err)
+}
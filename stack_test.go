@@ -0,0 +1,111 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+//nolint:paralleltest // Changes the stack trace capture.
+func TestStackTrace(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		err := errors.Wrap(errors.New("failed"), "oops")
+
+		require.Nil(t, errors.StackTrace(err))
+		require.Equal(t, "oops: failed\nfailed", fmt.Sprintf("%+v", err))
+	})
+
+	errors.EnableStackTrace(true)
+	defer errors.EnableStackTrace(false)
+
+	const caller = "github.com/dohernandez/errors_test.TestStackTrace.func"
+
+	t.Run("captured at the call point", func(t *testing.T) {
+		for name, err := range map[string]error{
+			"New":             errors.New("failed"),
+			"Newf":            errors.Newf("failed %d", 5),
+			"Wrap":            errors.Wrap(fmt.Errorf("failed"), "oops"),
+			"Wrapf":           errors.Wrapf(fmt.Errorf("failed"), "oops %d", 5),
+			"WrapError":       errors.WrapError(fmt.Errorf("failed"), fmt.Errorf("oops")),
+			"EnrichWrapError": errors.EnrichWrapError(fmt.Errorf("failed"), fmt.Errorf("oops"), "id", 5),
+			"WrapFile":        errors.WrapFile(fmt.Errorf("failed"), "open", "/tmp/oops"),
+		} {
+			frames := errors.StackTrace(err)
+			require.NotEmpty(t, frames, name)
+			assert.True(t, strings.HasPrefix(frames[0].Function, caller), name, frames[0].Function)
+		}
+	})
+
+	t.Run("deepest stack trace", func(t *testing.T) {
+		err := newError()
+		err = errors.WrapError(err, fmt.Errorf("oops"))
+
+		frames := errors.StackTrace(err)
+		require.NotEmpty(t, frames)
+		require.Equal(t, "github.com/dohernandez/errors_test.newError", frames[0].Function)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		err := errors.Enrich(errors.WrapError(newError(), errors.New("oops")), "id", 5)
+
+		require.Equal(t, "oops: failed", fmt.Sprintf("%v", err))
+		require.Equal(t, "oops: failed", fmt.Sprintf("%s", err))
+		require.Equal(t, `"oops: failed"`, fmt.Sprintf("%q", err))
+
+		lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+		require.Equal(t, "oops: failed", lines[0])
+		require.Contains(t, lines, "oops")
+		require.Contains(t, lines, "failed")
+		require.Contains(t, lines, "github.com/dohernandez/errors_test.newError")
+		require.Contains(t, fmt.Sprintf("%+v", err), caller)
+	})
+}
+
+func newError() error {
+	return errors.New("failed")
+}
+
+func TestWithDebugDetails(t *testing.T) {
+	t.Parallel()
+
+	// Invariant always captures the stack trace.
+	err := errors.Invariant(false, "broken")
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, errors.RemoteStackTrace(toStatus(t, err, codes.Internal).Err()))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		st := toStatus(t, err, codes.Internal, errors.WithDebugDetails(true))
+
+		entries := errors.RemoteStackTrace(fmt.Errorf("call: %w", st.Err()))
+		require.NotEmpty(t, entries)
+		require.True(t, strings.HasPrefix(entries[0], "github.com/dohernandez/errors_test.TestWithDebugDetails"), entries[0])
+		require.Contains(t, entries[0], "stack_test.go:")
+	})
+
+	t.Run("server interceptor", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := unaryServerInterceptor(t, errors.WithEnvelopeOptions(errors.WithDebugDetails(true)))
+
+		_, sErr := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{},
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, err
+			})
+
+		require.NotEmpty(t, errors.RemoteStackTrace(sErr))
+	})
+}
@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"fmt"
+	"time"
+)
+
+type staleError struct {
+	err      error
+	cachedAt time.Time
+}
+
+// Error implements the standard library error interface.
+func (se *staleError) Error() string {
+	return se.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (se *staleError) Unwrap() error {
+	return se.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (se *staleError) Format(st fmt.State, verb rune) {
+	formatError(se, st, verb)
+}
+
+// WithStaleness returns err marked as a cached result, cached at cachedAt.
+//
+// It is meant for systems caching negative results, so consumers can tell whether the error
+// reflects a recent attempt or an old cached failure, see IsStale.
+// If err is nil, WithStaleness returns nil.
+func WithStaleness(err error, cachedAt time.Time) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &staleError{
+		err:      err,
+		cachedAt: cachedAt,
+	}
+}
+
+// CachedAt returns the time err was cached at, see WithStaleness.
+func CachedAt(err error) (time.Time, bool) {
+	var se *staleError
+	if !As(err, &se) {
+		return time.Time{}, false
+	}
+
+	return se.cachedAt, true
+}
+
+// IsStale reports whether err is a cached result older than ttl.
+// Errors not marked with WithStaleness are never stale.
+func IsStale(err error, ttl time.Duration) bool {
+	cachedAt, ok := CachedAt(err)
+
+	return ok && now().Sub(cachedAt) > ttl
+}
@@ -0,0 +1,47 @@
+package errors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWithStaleness(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.WithStaleness(nil, time.Now()))
+	})
+
+	t.Run("not cached", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := errors.CachedAt(errFailed)
+		require.False(t, ok)
+		require.False(t, errors.IsStale(errFailed, 0))
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		t.Parallel()
+
+		cachedAt := time.Now().Add(-time.Minute)
+
+		err := errors.Wrap(errors.WithStaleness(errFailed, cachedAt), "oops")
+		require.EqualError(t, err, "oops: failed")
+		require.ErrorIs(t, err, errFailed)
+
+		at, ok := errors.CachedAt(err)
+		require.True(t, ok)
+		require.Equal(t, cachedAt, at)
+
+		require.True(t, errors.IsStale(err, time.Second))
+		require.False(t, errors.IsStale(err, time.Hour))
+	})
+}
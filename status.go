@@ -0,0 +1,256 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// chainDetailKey is the key of the status detail holding the error chain.
+const chainDetailKey = "dohernandez.errors.v1.chain"
+
+// ToStatus converts err into a grpc status.Status with the supplied code and err message.
+//
+// The error chain, with the messages and the key-value pairs of every link, is added
+// to the status details so the client can recreate it.
+// If err is nil, ToStatus returns a status with code codes.OK.
+func ToStatus(err error, code codes.Code, opts ...Option) *status.Status {
+	env := NewEnvelope(err, opts...)
+	if env == nil {
+		return status.New(codes.OK, "")
+	}
+
+	env.Code = code
+
+	return env.status()
+}
+
+// status converts the envelope into a grpc status.Status.
+func (e *Envelope) status() *status.Status {
+	st := status.New(e.Code, e.Message)
+
+	var details []protoadapt.MessageV1
+
+	if e.Chain != nil {
+		details = append(details, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				chainDetailKey: structpb.NewStructValue(e.Chain.structpb()),
+			},
+		})
+	}
+
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+
+	if len(e.Violations) > 0 {
+		details = append(details, badRequestOf(e.Violations))
+	}
+
+	if e.Reason != "" {
+		details = append(details, &errdetails.ErrorInfo{Domain: e.Domain, Reason: e.Reason})
+	}
+
+	if len(e.StackEntries) > 0 {
+		details = append(details, &errdetails.DebugInfo{StackEntries: e.StackEntries})
+	}
+
+	if e.LocalizedMessage != "" {
+		details = append(details, &errdetails.LocalizedMessage{Locale: e.Locale, Message: e.LocalizedMessage})
+	}
+
+	if e.Panic != nil {
+		details = append(details, e.Panic.structpb())
+	}
+
+	if len(e.Ops) > 0 {
+		details = append(details, opsStructpb(e.Ops))
+	}
+
+	if e.Kind != KindUnknown {
+		details = append(details, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				kindDetailKey: structpb.NewStringValue(string(e.Kind)),
+			},
+		})
+	}
+
+	if len(details) == 0 {
+		return st
+	}
+
+	dst, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return dst
+}
+
+// structpb encodes the link and the links it wraps.
+func (l *Link) structpb() *structpb.Struct {
+	s := &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"type":    structpb.NewStringValue(l.Type),
+			"message": structpb.NewStringValue(l.Message),
+		},
+	}
+
+	if l.Name != "" {
+		s.Fields["name"] = structpb.NewStringValue(l.Name)
+	}
+
+	if l.Err != nil {
+		s.Fields["err"] = structpb.NewStructValue(l.Err.structpb())
+	}
+
+	if l.Cause != nil {
+		s.Fields["cause"] = structpb.NewStructValue(l.Cause.structpb())
+	}
+
+	if l.Type == LinkEnriched {
+		s.Fields["fields"] = structpb.NewListValue(encodeTuples(l.Fields))
+	}
+
+	if len(l.Errs) > 0 {
+		errs := &structpb.ListValue{Values: make([]*structpb.Value, 0, len(l.Errs))}
+		for _, jl := range l.Errs {
+			errs.Values = append(errs.Values, structpb.NewStructValue(jl.structpb()))
+		}
+
+		s.Fields["errs"] = structpb.NewListValue(errs)
+	}
+
+	return s
+}
+
+// encodeTuples encodes key-value pairs, values not representable as structpb.Value are encoded as strings.
+func encodeTuples(t tuples) *structpb.ListValue {
+	list := &structpb.ListValue{Values: make([]*structpb.Value, 0, len(t))}
+
+	for _, v := range t {
+		pv, err := structpb.NewValue(v)
+		if err != nil {
+			pv = structpb.NewStringValue(fmt.Sprint(v))
+		}
+
+		list.Values = append(list.Values, pv)
+	}
+
+	return list
+}
+
+// FromStatus recreates the error chain from a grpc status.Status created by ToStatus.
+//
+// The links of the chain are recreated with their messages and key-value pairs, so Is matches
+// the sentinel errors of the package by message and the fields are available on the client.
+// If st has no error chain detail, FromStatus returns an error with the status message.
+// If st is nil or its code is codes.OK, FromStatus returns nil.
+func FromStatus(st *status.Status) error {
+	return envelopeFromStatus(st).Err()
+}
+
+// envelopeFromStatus returns the envelope of a grpc status.Status, nil if st is nil or its code is codes.OK.
+func envelopeFromStatus(st *status.Status) *Envelope {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	env := &Envelope{
+		Message:    st.Message(),
+		Code:       st.Code(),
+		HTTPStatus: httpStatusFromCode(st.Code()),
+	}
+
+	if s := chainStruct(st); s != nil {
+		env.Chain = linkFromStructpb(s)
+		env.Fields = tuples(keysAndValues(env.Chain.decode())).fields()
+	}
+
+	if ri := retryInfo(st); ri != nil {
+		env.RetryAfter = ri.GetRetryDelay().AsDuration()
+	}
+
+	env.Violations = violationsFromBadRequest(badRequest(st))
+
+	if ei := errorInfo(st); ei != nil {
+		env.Domain, env.Reason = ei.GetDomain(), ei.GetReason()
+	}
+
+	if di := debugInfo(st); di != nil {
+		env.StackEntries = di.GetStackEntries()
+	}
+
+	if lm := localizedMessageDetail(st); lm != nil {
+		env.Locale, env.LocalizedMessage = lm.GetLocale(), lm.GetMessage()
+	}
+
+	env.Kind = kindDetail(st)
+
+	if list := opsStruct(st); list != nil {
+		env.Ops = opsFromStructpb(list)
+	}
+
+	if ps := panicStruct(st); ps != nil {
+		info := panicInfoFromStructpb(ps)
+		env.Panic = &info
+	}
+
+	return env
+}
+
+// linkFromStructpb decodes the link and the links it wraps.
+func linkFromStructpb(s *structpb.Struct) *Link {
+	fields := s.GetFields()
+
+	l := &Link{
+		Type:    fields["type"].GetStringValue(),
+		Message: fields["message"].GetStringValue(),
+		Name:    fields["name"].GetStringValue(),
+		Fields:  fields["fields"].GetListValue().AsSlice(),
+	}
+
+	if child := fields["err"].GetStructValue(); child != nil {
+		l.Err = linkFromStructpb(child)
+	}
+
+	if child := fields["cause"].GetStructValue(); child != nil {
+		l.Cause = linkFromStructpb(child)
+	}
+
+	for _, v := range fields["errs"].GetListValue().GetValues() {
+		if child := v.GetStructValue(); child != nil {
+			l.Errs = append(l.Errs, linkFromStructpb(child))
+		}
+	}
+
+	return l
+}
+
+// chainStruct returns the outermost link of the error chain in the status details, nil if there is none.
+func chainStruct(st *status.Status) *structpb.Struct {
+	for _, d := range st.Details() {
+		chain, ok := d.(*structpb.Struct)
+		if !ok {
+			continue
+		}
+
+		if s := chain.GetFields()[chainDetailKey].GetStructValue(); s != nil {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// hasChain reports whether the status details carry an error chain.
+func hasChain(st *status.Status) bool {
+	return chainStruct(st) != nil
+}
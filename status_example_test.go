@@ -0,0 +1,54 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func ExampleToStatus() {
+	err := errors.Enrich(errors.New("user not found"), "id", 5)
+
+	st := errors.ToStatus(err, codes.NotFound)
+
+	fmt.Println(st.Code())
+	fmt.Println(st.Message())
+
+	// The client recreates the error, with its code and fields.
+	cErr := errors.FromStatus(st)
+
+	fmt.Println(cErr)
+	fmt.Println(errors.CodeOf(cErr))
+	fmt.Println(errors.Fields(cErr))
+
+	// Output:
+	// NotFound
+	// user not found
+	// user not found
+	// NotFound
+	// map[id:5]
+}
+
+func ExampleFromStatus() {
+	errNotFound := errors.New("not found")
+
+	// Server side.
+	err := errors.EnrichWrapError(errors.New("no rows"), errNotFound, "id", 5)
+	st := errors.ToStatus(err, codes.NotFound)
+
+	// Client side.
+	err = errors.FromStatus(st)
+
+	fmt.Println(st.Code())
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, errNotFound))
+
+	// Output:
+	// NotFound
+	// not found: no rows
+	// true
+}
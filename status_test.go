@@ -0,0 +1,141 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestToStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		st := errors.ToStatus(nil, codes.Internal)
+		require.Equal(t, codes.OK, st.Code())
+		require.NoError(t, st.Err())
+	})
+
+	t.Run("error chain", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.EnrichWrapError(
+			errors.Wrap(fmt.Errorf("failed: %w", errors.New("io")), "read"),
+			errors.New("oops"),
+			"id", 5, "bInt", big.NewInt(42),
+		)
+
+		st := errors.ToStatus(err, codes.NotFound)
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "oops: read: failed: io", st.Message())
+
+		details := st.Details()
+		require.Len(t, details, 1)
+
+		chain, ok := details[0].(*structpb.Struct)
+		require.True(t, ok, "detail is not a structpb.Struct")
+		require.Equal(t, map[string]interface{}{
+			"dohernandez.errors.v1.chain": map[string]interface{}{
+				"type":    "enriched",
+				"message": "oops: read: failed: io",
+				"fields":  []interface{}{"id", 5.0, "bInt", "42"},
+				"err": map[string]interface{}{
+					"type":    "error",
+					"message": "oops: read: failed: io",
+					"err": map[string]interface{}{
+						"type":    "string",
+						"message": "oops",
+					},
+					"cause": map[string]interface{}{
+						"type":    "message",
+						"message": "read: failed: io",
+						"err": map[string]interface{}{
+							"type":    "message",
+							"message": "failed: io",
+							"err": map[string]interface{}{
+								"type":    "string",
+								"message": "io",
+							},
+						},
+					},
+				},
+			},
+		}, chain.AsMap())
+	})
+}
+
+func TestFromStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, errors.FromStatus(nil))
+		require.NoError(t, errors.FromStatus(status.New(codes.OK, "")))
+	})
+
+	t.Run("without chain", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.FromStatus(status.New(codes.NotFound, "not found"))
+		require.EqualError(t, err, "not found")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		errIO := errors.New("io")
+		errOops := errors.New("oops")
+
+		err := errors.EnrichWrapError(
+			errors.Enrich(errors.Wrap(fmt.Errorf("failed: %w", errIO), "read"), "path", "/tmp"),
+			errOops,
+			"id", 5,
+		)
+
+		cErr := errors.FromStatus(errors.ToStatus(err, codes.NotFound))
+		require.EqualError(t, cErr, "oops: read: failed: io")
+		require.ErrorIs(t, cErr, errOops)
+		require.ErrorIs(t, cErr, errIO)
+		require.NotErrorIs(t, cErr, errors.New("failed"))
+		require.Equal(t, codes.NotFound, errors.CodeOf(cErr))
+		require.Equal(t, http.StatusNotFound, errors.HTTPStatusOf(cErr))
+
+		errKV := enrichedOf(t, cErr)
+		require.Equal(t, []interface{}{"id", 5.0, "path", "/tmp"}, errKV.Tuples())
+
+		require.EqualError(t, errors.Cause(errors.Unwrap(errKV)), "read: failed: io")
+	})
+}
+
+func TestCodeOf_status(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(status.Error(codes.AlreadyExists, "exists"), "create")
+
+	require.Equal(t, codes.AlreadyExists, errors.CodeOf(err))
+	require.Equal(t, http.StatusConflict, errors.HTTPStatusOf(err))
+}
+
+func TestToStatus_classified(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Enrich(errors.New("failed"), append(errors.FieldC("email", "jane@example.com", errors.PII), "id", 5)...)
+
+	cErr := errors.FromStatus(errors.ToStatus(err, codes.InvalidArgument))
+	require.Equal(t, map[string]interface{}{"email": errors.RedactedValue, "id": 5.0}, errors.Fields(cErr))
+
+	cErr = errors.FromStatus(errors.ToStatus(err, codes.InvalidArgument, errors.WithFieldPolicy(errors.PII, errors.DropField)))
+	require.Equal(t, map[string]interface{}{"id": 5.0}, errors.Fields(cErr))
+}
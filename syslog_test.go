@@ -0,0 +1,52 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestSyslogStructuredData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "-", errors.SyslogStructuredData(nil))
+	})
+
+	t.Run("without fields", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, `[error@32473 message="failed" code="Unknown"]`, errors.SyslogStructuredData(errors.New("failed")))
+	})
+
+	t.Run("with fields", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.EnrichWrapError(
+			errors.Enrich(errors.New(`failed "badly"`), "user id", 5),
+			errors.New("oops]"),
+			"path", `c:\tmp`,
+		)
+
+		require.Equal(t,
+			`[error@32473 message="oops\]: failed \"badly\"" code="Unknown" path="c:\\tmp" user_id="5"]`,
+			errors.SyslogStructuredData(err),
+		)
+	})
+
+	t.Run("with code and kind", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithKind(errors.Enrich(errors.New("no rows"), "id", 5), errors.KindNotFound)
+
+		require.Equal(t, `[error@32473 message="no rows" code="NotFound" kind="not_found" id="5"]`,
+			errors.SyslogStructuredData(err))
+		require.Equal(t, `[error@32473 message="no rows" code="NotFound"]`,
+			errors.SyslogStructuredData(errors.WithCode(errors.New("no rows"), codes.NotFound)))
+	})
+}
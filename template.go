@@ -0,0 +1,51 @@
+package errors
+
+import "fmt"
+
+// MessageTemplate creates errors whose message and fields are bound to the same arguments, see Template.
+type MessageTemplate struct {
+	format string
+	keys   []string
+}
+
+// Template returns a MessageTemplate formatting messages with format and binding the arguments,
+// in order, to the keys of the fields.
+//
+//	var errUserNotFound = errors.Template("user %s not found in org %s", "user", "org")
+//
+//	err := errUserNotFound.New(user, org) // enriched with "user" and "org".
+func Template(format string, keys ...string) *MessageTemplate {
+	return &MessageTemplate{
+		format: format,
+		keys:   append([]string(nil), keys...),
+	}
+}
+
+// New returns an error without cause with the message formatted with args, enriched with the fields
+// binding the keys of the template to args. Arguments without key, or keys without argument, are not bound.
+func (t *MessageTemplate) New(args ...any) error {
+	return newKV(fmt.Sprintf(t.format, args...), callers(), t.bind(args))
+}
+
+// Wrap returns an error annotating err with a stack trace at the point Wrap is called, and the message
+// formatted with args, enriched with the fields binding the keys of the template to args, see New.
+// If err is nil, Wrap returns nil.
+func (t *MessageTemplate) Wrap(err error, args ...any) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return Enrich(wrap(err, fmt.Sprintf(t.format, args...), callers()), t.bind(args)...)
+}
+
+// bind returns the key-value pairs of the keys and args.
+func (t *MessageTemplate) bind(args []any) []interface{} {
+	n := min(len(t.keys), len(args))
+	kv := make([]interface{}, 0, 2*n)
+
+	for i := 0; i < n; i++ {
+		kv = append(kv, t.keys[i], args[i])
+	}
+
+	return kv
+}
@@ -0,0 +1,45 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestTemplate(t *testing.T) {
+	t.Parallel()
+
+	errUserNotFound := errors.Template("user %s not found in org %s", "user", "org")
+
+	t.Run("New", func(t *testing.T) {
+		t.Parallel()
+
+		err := errUserNotFound.New("bob", "acme")
+		require.EqualError(t, err, "user bob not found in org acme")
+		assert.Equal(t, map[string]interface{}{"user": "bob", "org": "acme"}, errors.Fields(err))
+	})
+
+	t.Run("Wrap", func(t *testing.T) {
+		t.Parallel()
+
+		cause := errors.New("no rows")
+
+		err := errUserNotFound.Wrap(cause, "bob", "acme")
+		require.EqualError(t, err, "user bob not found in org acme: no rows")
+		require.ErrorIs(t, err, cause)
+		assert.Equal(t, []interface{}{"user", "bob", "org", "acme"}, errors.Tuples(err))
+
+		require.NoError(t, errUserNotFound.Wrap(nil, "bob", "acme"))
+	})
+
+	t.Run("missing arguments", func(t *testing.T) {
+		t.Parallel()
+
+		err := errUserNotFound.New("bob")
+		require.EqualError(t, err, "user bob not found in org %!s(MISSING)")
+		assert.Equal(t, map[string]interface{}{"user": "bob"}, errors.Fields(err))
+	})
+}
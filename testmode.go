@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// TestModeTime is the time of the frozen Clock installed by TestMode.
+var TestModeTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// testMode disables the capture of stack traces, see TestMode.
+var testMode atomic.Bool
+
+// frozenClock is a Clock always returning the same time.
+type frozenClock time.Time
+
+// Now returns the frozen time.
+func (c frozenClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// TestMode makes the output of the package byte-stable for golden tests and examples:
+//   - the Clock is frozen at TestModeTime, so elapsed times are zero, see WithTimeout,
+//   - stack traces are never captured, whatever the Settings and the Verbosity, so they are absent
+//     from every rendering and the fingerprints of recovered panics are empty.
+//
+// The package does not generate IDs, there are none to replace.
+//
+// It returns a function restoring the previous behavior, meant to be deferred or passed to testing.T.Cleanup.
+// TestMode changes the whole package, the tests calling it must not run in parallel with tests depending
+// on the clock or on stack traces.
+func TestMode() (restore func()) {
+	prevClock := clock.Load()
+	prevMode := testMode.Swap(true)
+
+	SetClock(frozenClock(TestModeTime))
+
+	return func() {
+		testMode.Store(prevMode)
+		clock.Store(prevClock)
+	}
+}
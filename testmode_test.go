@@ -0,0 +1,43 @@
+package errors_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+//nolint:paralleltest // Changes the package clock and stack traces.
+func TestTestMode(t *testing.T) {
+	errors.EnableStackTrace(true)
+	defer errors.EnableStackTrace(false)
+
+	restore := errors.TestMode()
+
+	err := errors.Wrap(errors.New("failed"), "oops")
+	assert.Nil(t, errors.StackTrace(err))
+	assert.Equal(t, "oops: failed\nfailed", fmt.Sprintf("%+v", err))
+
+	info, ok := errors.PanicInfoOf(errors.Recover("boom"))
+	require.True(t, ok)
+	assert.Equal(t, errors.PanicInfo{Value: "boom"}, info)
+
+	err = errors.WithTimeout(context.Background(), "fetch", time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+	assert.Equal(t, time.Duration(0), errors.Fields(err)["elapsed"])
+
+	restore()
+
+	assert.NotNil(t, errors.StackTrace(errors.New("failed")))
+
+	info, _ = errors.PanicInfoOf(errors.Recover("boom"))
+	assert.NotEmpty(t, info.Fingerprint)
+}
@@ -0,0 +1,33 @@
+package errors
+
+import (
+	"context"
+	"time"
+)
+
+// WithTimeout runs fn with a context derived from ctx that expires after timeout.
+//
+// If fn fails once the deadline is exceeded, WithTimeout returns an error matching context.DeadlineExceeded,
+// annotated with op and enriched with the operation name, the configured timeout and the elapsed time.
+// fn is expected to honour the context, WithTimeout does not abandon it.
+func WithTimeout(ctx context.Context, op string, timeout time.Duration, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := now()
+
+	err := fn(ctx)
+	if err == nil || !Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	elapsed := now().Sub(start)
+
+	if Is(err, context.DeadlineExceeded) {
+		err = Wrap(err, op)
+	} else {
+		err = WrapError(err, Wrap(context.DeadlineExceeded, op))
+	}
+
+	return Enrich(err, "operation", op, "timeout", timeout, "elapsed", elapsed)
+}
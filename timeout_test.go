@@ -0,0 +1,68 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithTimeout(context.Background(), "fetch", time.Second, func(context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("failure before deadline", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithTimeout(context.Background(), "fetch", time.Second, func(context.Context) error {
+			return errFailed
+		})
+		require.Equal(t, errFailed, err)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithTimeout(context.Background(), "fetch", time.Millisecond, func(ctx context.Context) error {
+			<-ctx.Done()
+
+			return ctx.Err()
+		})
+		require.EqualError(t, err, "fetch: context deadline exceeded")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		errKV, ok := err.(enrichedError)
+		require.True(t, ok, "error does not implement enrichedError interface")
+
+		fields := errKV.Fields()
+		require.Equal(t, "fetch", fields["operation"])
+		require.Equal(t, time.Millisecond, fields["timeout"])
+		require.GreaterOrEqual(t, fields["elapsed"], time.Millisecond)
+	})
+
+	t.Run("failure after deadline", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WithTimeout(context.Background(), "fetch", time.Millisecond, func(ctx context.Context) error {
+			<-ctx.Done()
+
+			return errFailed
+		})
+		require.EqualError(t, err, "fetch: context deadline exceeded: failed")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorIs(t, err, errFailed)
+	})
+}
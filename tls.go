@@ -0,0 +1,111 @@
+package errors
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+)
+
+// ErrCertificate is the error matched by the certificate errors wrapped by WrapTLS.
+var ErrCertificate = NewSentinel("errors.InvalidCertificate", "invalid certificate")
+
+// Keys of the fields attached by WrapTLS.
+const (
+	TLSServerNameKey    = "server_name"
+	TLSReasonKey        = "tls_reason"
+	CertSubjectKey      = "cert_subject"
+	CertIssuerKey       = "cert_issuer"
+	CertNotBeforeKey    = "cert_not_before"
+	CertNotAfterKey     = "cert_not_after"
+	CertDNSNamesKey     = "cert_dns_names"
+	CertVerifiedHostKey = "cert_host"
+)
+
+// Values of the TLSReasonKey field.
+const (
+	TLSReasonExpired          = "expired"
+	TLSReasonNotYetValid      = "not_yet_valid"
+	TLSReasonHostnameMismatch = "hostname_mismatch"
+	TLSReasonUnknownAuthority = "unknown_authority"
+	TLSReasonInvalid          = "invalid"
+)
+
+// WrapTLS returns err enriched with the name of the server the TLS connection was made to,
+// as the TLSServerNameKey field.
+//
+// When err is caused by the verification of a certificate, e.g. an x509.CertificateInvalidError,
+// x509.HostnameError or x509.UnknownAuthorityError, the returned error also matches ErrCertificate and carries
+// the reason, see TLSReasonKey, and the subject, issuer, validity period and DNS names of the certificate.
+// If err is nil, WrapTLS returns nil.
+func WrapTLS(err error, serverName string) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	kv := []interface{}{TLSServerNameKey, serverName}
+
+	reason, cert, host := certificateError(err)
+	if reason == "" {
+		return Enrich(err, kv...)
+	}
+
+	kv = append(kv, TLSReasonKey, reason)
+
+	if host != "" {
+		kv = append(kv, CertVerifiedHostKey, host)
+	}
+
+	if cert != nil {
+		kv = append(kv,
+			CertSubjectKey, cert.Subject.String(),
+			CertIssuerKey, cert.Issuer.String(),
+			CertNotBeforeKey, cert.NotBefore.UTC().Format(time.RFC3339),
+			CertNotAfterKey, cert.NotAfter.UTC().Format(time.RFC3339),
+		)
+
+		if len(cert.DNSNames) > 0 {
+			kv = append(kv, CertDNSNamesKey, cert.DNSNames)
+		}
+	}
+
+	return Enrich(wrapError(err, ErrCertificate, callers()), kv...)
+}
+
+// certificateError returns the reason, the certificate and the verified host of the certificate error of the
+// chain of err, an empty reason if there is none.
+func certificateError(err error) (string, *x509.Certificate, string) {
+	var (
+		invalidErr   x509.CertificateInvalidError
+		hostnameErr  x509.HostnameError
+		authorityErr x509.UnknownAuthorityError
+		verifyErr    *tls.CertificateVerificationError
+	)
+
+	switch {
+	case As(err, &invalidErr):
+		reason := TLSReasonInvalid
+
+		if invalidErr.Reason == x509.Expired && invalidErr.Cert != nil {
+			reason = TLSReasonExpired
+
+			if now().Before(invalidErr.Cert.NotBefore) {
+				reason = TLSReasonNotYetValid
+			}
+		}
+
+		return reason, invalidErr.Cert, ""
+	case As(err, &hostnameErr):
+		return TLSReasonHostnameMismatch, hostnameErr.Certificate, hostnameErr.Host
+	case As(err, &authorityErr):
+		return TLSReasonUnknownAuthority, authorityErr.Cert, ""
+	case As(err, &verifyErr):
+		var cert *x509.Certificate
+		if len(verifyErr.UnverifiedCertificates) > 0 {
+			cert = verifyErr.UnverifiedCertificates[0]
+		}
+
+		return TLSReasonInvalid, cert, ""
+	default:
+		return "", nil, ""
+	}
+}
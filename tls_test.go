@@ -0,0 +1,95 @@
+package errors_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWrapTLS(t *testing.T) {
+	t.Parallel()
+
+	cert := &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "api.example.com"},
+		Issuer:    pkix.Name{CommonName: "Example CA"},
+		NotBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		DNSNames:  []string{"api.example.com"},
+	}
+
+	certFields := map[string]interface{}{
+		errors.CertSubjectKey:   "CN=api.example.com",
+		errors.CertIssuerKey:    "CN=Example CA",
+		errors.CertNotBeforeKey: "2020-01-01T00:00:00Z",
+		errors.CertNotAfterKey:  "2021-01-01T00:00:00Z",
+		errors.CertDNSNamesKey:  []string{"api.example.com"},
+	}
+
+	require.NoError(t, errors.WrapTLS(nil, "api.example.com"))
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		reason string
+		host   string
+	}{
+		{
+			name:   "expired",
+			err:    x509.CertificateInvalidError{Cert: cert, Reason: x509.Expired},
+			reason: errors.TLSReasonExpired,
+		},
+		{
+			name:   "hostname mismatch",
+			err:    &tls.CertificateVerificationError{Err: x509.HostnameError{Certificate: cert, Host: "api.example.org"}},
+			reason: errors.TLSReasonHostnameMismatch,
+			host:   "api.example.org",
+		},
+		{
+			name:   "unknown authority",
+			err:    errors.Wrap(x509.UnknownAuthorityError{Cert: cert}, "dial"),
+			reason: errors.TLSReasonUnknownAuthority,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := errors.WrapTLS(tc.err, "api.example.com")
+
+			require.ErrorIs(t, err, errors.ErrCertificate)
+			require.ErrorIs(t, err, tc.err)
+			require.EqualError(t, err, "invalid certificate: "+tc.err.Error())
+
+			expected := map[string]interface{}{
+				errors.TLSServerNameKey: "api.example.com",
+				errors.TLSReasonKey:     tc.reason,
+			}
+
+			for k, v := range certFields {
+				expected[k] = v
+			}
+
+			if tc.host != "" {
+				expected[errors.CertVerifiedHostKey] = tc.host
+			}
+
+			assert.Equal(t, expected, errors.Fields(err))
+		})
+	}
+
+	t.Run("not a certificate error", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.WrapTLS(tls.AlertError(40), "api.example.com")
+
+		require.NotErrorIs(t, err, errors.ErrCertificate)
+		assert.Equal(t, map[string]interface{}{errors.TLSServerNameKey: "api.example.com"}, errors.Fields(err))
+		require.NotErrorIs(t, errors.New("invalid certificate"), errors.ErrCertificate, "only the sentinel matches")
+	})
+}
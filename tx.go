@@ -0,0 +1,58 @@
+package errors
+
+import "context"
+
+// Errors of the transaction steps run by Tx.
+var (
+	ErrTxBegin    = NewSentinel("errors.TxBegin", "begin transaction")
+	ErrTxCommit   = NewSentinel("errors.TxCommit", "commit transaction")
+	ErrTxRollback = NewSentinel("errors.TxRollback", "rollback transaction")
+)
+
+// Transaction is a transaction committed or rolled back by Tx, e.g. *sql.Tx.
+type Transaction interface {
+	Commit() error
+	Rollback() error
+}
+
+// Tx runs fn in a transaction started by begin, committing it when fn succeeds and rolling it back otherwise.
+//
+// Failures to begin, commit or roll back are wrapped with ErrTxBegin, ErrTxCommit or ErrTxRollback.
+// A rollback failure is joined to the error of fn, so neither is lost. If fn panics, the transaction
+// is rolled back and the panic propagated.
+//
+//	err := errors.Tx(ctx, func(ctx context.Context) (*sql.Tx, error) {
+//		return db.BeginTx(ctx, nil)
+//	}, func(ctx context.Context, tx *sql.Tx) error {
+//		_, err := tx.ExecContext(ctx, query)
+//
+//		return err
+//	})
+func Tx[T Transaction](ctx context.Context, begin func(ctx context.Context) (T, error), fn func(ctx context.Context, tx T) error) error {
+	tx, err := begin(ctx)
+	if err != nil {
+		return WrapError(err, ErrTxBegin)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback() //nolint:errcheck // The panic is propagated.
+
+			panic(r)
+		}
+	}()
+
+	if err := fn(ctx, tx); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return Join(err, WrapError(rErr, ErrTxRollback))
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return WrapError(err, ErrTxCommit)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+type transaction struct {
+	commitErr, rollbackErr error
+	committed, rolledBack  bool
+}
+
+func (tx *transaction) Commit() error {
+	tx.committed = true
+
+	return tx.commitErr
+}
+
+func (tx *transaction) Rollback() error {
+	tx.rolledBack = true
+
+	return tx.rollbackErr
+}
+
+func TestTx(t *testing.T) {
+	t.Parallel()
+
+	run := func(tx *transaction, beginErr error, fn func(context.Context, *transaction) error) error {
+		return errors.Tx(context.Background(), func(context.Context) (*transaction, error) {
+			return tx, beginErr
+		}, fn)
+	}
+
+	ok := func(context.Context, *transaction) error { return nil }
+	errFailed := errors.New("insert failed")
+	failed := func(context.Context, *transaction) error { return errFailed }
+
+	t.Run("commit", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction{}
+
+		require.NoError(t, run(tx, nil, ok))
+		assert.True(t, tx.committed)
+		assert.False(t, tx.rolledBack)
+	})
+
+	t.Run("begin failure", func(t *testing.T) {
+		t.Parallel()
+
+		errConn := errors.New("connection refused")
+
+		err := run(nil, errConn, ok)
+		require.ErrorIs(t, err, errors.ErrTxBegin)
+		require.ErrorIs(t, err, errConn)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction{}
+
+		err := run(tx, nil, failed)
+		require.ErrorIs(t, err, errFailed)
+		require.NotErrorIs(t, err, errors.ErrTxRollback)
+		require.NotErrorIs(t, errors.New("rollback transaction"), errors.ErrTxRollback, "only the sentinel matches")
+		assert.True(t, tx.rolledBack)
+		assert.False(t, tx.committed)
+	})
+
+	t.Run("rollback failure", func(t *testing.T) {
+		t.Parallel()
+
+		errBroken := errors.New("connection broken")
+		tx := &transaction{rollbackErr: errBroken}
+
+		err := run(tx, nil, failed)
+		require.ErrorIs(t, err, errFailed)
+		require.ErrorIs(t, err, errors.ErrTxRollback)
+		require.ErrorIs(t, err, errBroken)
+	})
+
+	t.Run("commit failure", func(t *testing.T) {
+		t.Parallel()
+
+		errConflict := errors.New("serialization failure")
+		tx := &transaction{commitErr: errConflict}
+
+		err := run(tx, nil, ok)
+		require.ErrorIs(t, err, errors.ErrTxCommit)
+		require.ErrorIs(t, err, errConflict)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction{}
+
+		assert.PanicsWithValue(t, "boom", func() {
+			_ = run(tx, nil, func(context.Context, *transaction) error { panic("boom") })
+		})
+		assert.True(t, tx.rolledBack)
+	})
+}
@@ -0,0 +1,219 @@
+//go:build !errors_minimal
+
+package errors
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidationViolationsFullName is the full name of the protovalidate message listing the violations of a request.
+const ValidationViolationsFullName protoreflect.FullName = "buf.validate.Violations"
+
+// WrapValidation returns err, a request validation failure, as a codes.InvalidArgument error carrying
+// its field violations, see FieldViolations.
+//
+// Both the errors of protovalidate (*protovalidate.ValidationError) and the ones generated by protoc-gen-validate
+// (the ValidationError and MultiError types) are supported, without depending on them: the violations are read
+// from the buf.validate.Violations message returned by the ToProto method, see ProtoViolations, or from the Field,
+// Reason, Cause and AllErrors methods. The paths of nested fields are preserved, e.g. "user.emails[0]".
+// Other errors are returned untouched.
+//
+//	if err := validator.Validate(req); err != nil {
+//		return nil, errors.WrapValidation(err)
+//	}
+func WrapValidation(err error) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	violations := validationViolations(err)
+	if len(violations) == 0 {
+		return err
+	}
+
+	return FieldViolations(WithCode(err, codes.InvalidArgument), violations...)
+}
+
+// ProtoViolations returns the field violations of m, a buf.validate.Violations message as returned by
+// the ToProto method of *protovalidate.ValidationError, nil if m is not one.
+//
+// The description of a violation is its message, or its rule id when the message is empty.
+func ProtoViolations(m proto.Message) []FieldViolation {
+	if m == nil {
+		return nil
+	}
+
+	pm := m.ProtoReflect()
+	if !pm.IsValid() || pm.Descriptor().FullName() != ValidationViolationsFullName {
+		return nil
+	}
+
+	list := pm.Get(pm.Descriptor().Fields().ByName("violations")).List()
+	violations := make([]FieldViolation, 0, list.Len())
+
+	for i := range list.Len() {
+		v := list.Get(i).Message()
+
+		description := stringField(v, "message")
+		if description == "" {
+			description = stringField(v, "rule_id")
+		}
+
+		violations = append(violations, FieldViolation{
+			Field:       protoFieldPath(v),
+			Description: description,
+		})
+	}
+
+	return violations
+}
+
+// protoFieldPath returns the path of the field of a buf.validate.Violation, e.g. "user.emails[0]".
+func protoFieldPath(v protoreflect.Message) string {
+	fd := v.Descriptor().Fields().ByName("field")
+	if fd == nil || !v.Has(fd) {
+		// Older versions only have the deprecated field_path.
+		return stringField(v, "field_path")
+	}
+
+	elements := v.Get(fd).Message()
+	list := elements.Get(elements.Descriptor().Fields().ByName("elements")).List()
+
+	var sb strings.Builder
+
+	for i := range list.Len() {
+		e := list.Get(i).Message()
+
+		if i > 0 {
+			sb.WriteString(".")
+		}
+
+		sb.WriteString(stringField(e, "field_name"))
+
+		od := e.Descriptor().Oneofs().ByName("subscript")
+		if od == nil {
+			continue
+		}
+
+		sd := e.WhichOneof(od)
+		if sd == nil {
+			continue
+		}
+
+		switch sv := e.Get(sd); sd.Kind() { //nolint:exhaustive
+		case protoreflect.StringKind:
+			sb.WriteString("[" + strconv.Quote(sv.String()) + "]")
+		default:
+			sb.WriteString("[" + sv.String() + "]")
+		}
+	}
+
+	return sb.String()
+}
+
+// stringField returns the value of the string field name of m, empty if m has none.
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return m.Get(fd).String()
+}
+
+// validationViolations returns the field violations of the protovalidate or protoc-gen-validate error of the chain
+// of err.
+func validationViolations(err error) []FieldViolation {
+	var violations []FieldViolation
+
+	walk(err, func(err error) bool {
+		if m := toProto(err); m != nil {
+			violations = ProtoViolations(m)
+		}
+
+		if violations == nil && isPGVError(err) {
+			violations = pgvViolations("", err)
+		}
+
+		return violations == nil
+	})
+
+	return violations
+}
+
+// toProto returns the message returned by the ToProto method of err, nil if it has none.
+//
+// The method is looked up by reflection, as its result is a concrete type of protovalidate.
+func toProto(err error) proto.Message {
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+
+	m := v.MethodByName("ToProto")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+
+	pm, _ := m.Call(nil)[0].Interface().(proto.Message) //nolint:errcheck
+
+	return pm
+}
+
+// pgvFieldError is the error generated by protoc-gen-validate for an invalid field.
+type pgvFieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// pgvMultiError is the error generated by protoc-gen-validate listing all the invalid fields.
+type pgvMultiError interface {
+	AllErrors() []error
+}
+
+// isPGVError reports whether err is an error generated by protoc-gen-validate.
+func isPGVError(err error) bool {
+	//nolint:errorlint
+	switch err.(type) {
+	case pgvFieldError, pgvMultiError:
+		return true
+	default:
+		return false
+	}
+}
+
+// pgvViolations returns the field violations of a protoc-gen-validate error, prefixing the fields with prefix.
+func pgvViolations(prefix string, err error) []FieldViolation {
+	//nolint:errorlint
+	switch e := err.(type) {
+	case pgvMultiError:
+		var violations []FieldViolation
+
+		for _, fErr := range e.AllErrors() {
+			violations = append(violations, pgvViolations(prefix, fErr)...)
+		}
+
+		return violations
+	case pgvFieldError:
+		field := e.Field()
+		if prefix != "" {
+			field = prefix + "." + field
+		}
+
+		// Embedded messages report the violations of their fields as cause.
+		if cause := e.Cause(); cause != nil && isPGVError(cause) {
+			return pgvViolations(field, cause)
+		}
+
+		return []FieldViolation{{Field: field, Description: e.Reason()}}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,229 @@
+//go:build !errors_minimal
+
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/dohernandez/errors"
+)
+
+// validateFile is the subset of buf/validate/validate.proto describing violations.
+var validateFile = func() protoreflect.FileDescriptor {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   typ.Enum(),
+		}
+
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+
+		return f
+	}
+
+	repeated := func(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+		f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+		return f
+	}
+
+	subscript := func(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+		f.OneofIndex = proto.Int32(0)
+
+		return f
+	}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("buf/validate/validate.proto"),
+		Package: proto.String("buf.validate"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Violations"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					repeated(field("violations", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.Violation")),
+				},
+			},
+			{
+				Name: proto.String("Violation"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("field_path", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("rule_id", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("message", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("field", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.FieldPath"),
+				},
+			},
+			{
+				Name: proto.String("FieldPath"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					repeated(field("elements", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".buf.validate.FieldPathElement")),
+				},
+			},
+			{
+				Name: proto.String("FieldPathElement"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("field_name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					subscript(field("index", 6, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "")),
+					subscript(field("string_key", 10, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")),
+				},
+				OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("subscript")}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return fd
+}()
+
+// pathElement is a field of a violation path, with an optional index or string key subscript.
+type pathElement struct {
+	name  string
+	index *uint64
+	key   *string
+}
+
+// violations returns a buf.validate.Violations message.
+func violations(vs ...[]pathElement) proto.Message {
+	msgs := validateFile.Messages()
+
+	m := dynamicpb.NewMessage(msgs.ByName("Violations"))
+	list := m.Mutable(msgs.ByName("Violations").Fields().ByName("violations")).List()
+
+	for i, path := range vs {
+		v := dynamicpb.NewMessage(msgs.ByName("Violation"))
+		v.Set(msgs.ByName("Violation").Fields().ByName("rule_id"), protoreflect.ValueOfString("rule"))
+
+		if i == 0 {
+			v.Set(msgs.ByName("Violation").Fields().ByName("message"), protoreflect.ValueOfString("is required"))
+		}
+
+		fp := v.Mutable(msgs.ByName("Violation").Fields().ByName("field")).Message()
+		elements := fp.Mutable(msgs.ByName("FieldPath").Fields().ByName("elements")).List()
+
+		for _, pe := range path {
+			ed := msgs.ByName("FieldPathElement")
+			e := dynamicpb.NewMessage(ed)
+			e.Set(ed.Fields().ByName("field_name"), protoreflect.ValueOfString(pe.name))
+
+			if pe.index != nil {
+				e.Set(ed.Fields().ByName("index"), protoreflect.ValueOfUint64(*pe.index))
+			}
+
+			if pe.key != nil {
+				e.Set(ed.Fields().ByName("string_key"), protoreflect.ValueOfString(*pe.key))
+			}
+
+			elements.Append(protoreflect.ValueOfMessage(e))
+		}
+
+		list.Append(protoreflect.ValueOfMessage(v))
+	}
+
+	return m
+}
+
+// protovalidateError mimics *protovalidate.ValidationError.
+type protovalidateError struct {
+	violations proto.Message
+}
+
+func (e *protovalidateError) Error() string {
+	return "validation error"
+}
+
+func (e *protovalidateError) ToProto() proto.Message {
+	return e.violations
+}
+
+// pgvError mimics the ValidationError types generated by protoc-gen-validate.
+type pgvError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e pgvError) Error() string  { return "invalid " + e.field + ": " + e.reason }
+func (e pgvError) Field() string  { return e.field }
+func (e pgvError) Reason() string { return e.reason }
+func (e pgvError) Cause() error   { return e.cause }
+
+// pgvMultiError mimics the MultiError types generated by protoc-gen-validate.
+type pgvMultiError []error
+
+func (m pgvMultiError) Error() string      { return "multiple validation errors" }
+func (m pgvMultiError) AllErrors() []error { return m }
+
+func TestWrapValidation(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, errors.WrapValidation(nil))
+
+	t.Run("protovalidate", func(t *testing.T) {
+		t.Parallel()
+
+		index, key := uint64(1), "home"
+
+		vErr := &protovalidateError{violations: violations(
+			[]pathElement{{name: "user"}, {name: "email"}},
+			[]pathElement{{name: "emails", index: &index}},
+			[]pathElement{{name: "addresses", key: &key}, {name: "zip"}},
+		)}
+
+		err := errors.WrapValidation(errors.Wrap(vErr, "create user"))
+
+		require.ErrorIs(t, err, vErr)
+		assert.Equal(t, codes.InvalidArgument, errors.CodeOf(err))
+		assert.Equal(t, []errors.FieldViolation{
+			{Field: "user.email", Description: "is required"},
+			{Field: "emails[1]", Description: "rule"},
+			{Field: `addresses["home"].zip`, Description: "rule"},
+		}, errors.Violations(err))
+
+		st := errors.ToStatus(err, errors.CodeOf(err))
+		assert.Equal(t, errors.Violations(err), errors.Violations(errors.FromStatus(st)))
+	})
+
+	t.Run("protoc-gen-validate", func(t *testing.T) {
+		t.Parallel()
+
+		vErr := pgvMultiError{
+			pgvError{field: "Name", reason: "value length must be at least 1 runes"},
+			pgvError{field: "User", reason: "embedded message failed validation", cause: pgvMultiError{
+				pgvError{field: "Email", reason: "value must be a valid email address"},
+			}},
+		}
+
+		err := errors.WrapValidation(vErr)
+
+		assert.Equal(t, codes.InvalidArgument, errors.CodeOf(err))
+		assert.Equal(t, []errors.FieldViolation{
+			{Field: "Name", Description: "value length must be at least 1 runes"},
+			{Field: "User.Email", Description: "value must be a valid email address"},
+		}, errors.Violations(err))
+	})
+
+	t.Run("not a validation error", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("failed")
+
+		require.Equal(t, err, errors.WrapValidation(err))
+		assert.Nil(t, errors.ProtoViolations(nil))
+		assert.Nil(t, errors.ProtoViolations(&descriptorpb.FileDescriptorProto{}))
+	})
+}
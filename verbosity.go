@@ -0,0 +1,164 @@
+package errors
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// VerbosityEnv is the environment variable read at init to set the Verbosity,
+// one of "off", "brief", "detailed" or "full".
+const VerbosityEnv = "ERRORS_VERBOSITY"
+
+// ErrInvalidVerbosity is returned by ParseVerbosity for unknown verbosity names.
+var ErrInvalidVerbosity = NewSentinel("errors.InvalidVerbosity", "invalid verbosity")
+
+// Verbosity is the level of detail of Render.
+type Verbosity int32
+
+// Verbosity levels, from the least to the most detailed.
+const (
+	// VerbosityOff renders nothing.
+	VerbosityOff Verbosity = iota
+	// VerbosityBrief renders the outermost message, see Brief.
+	VerbosityBrief
+	// VerbosityDetailed renders the message with its chain, see Detailed. It is the default.
+	VerbosityDetailed
+	// VerbosityFull renders the chain with stack traces and fields, see Full.
+	// It also enables the capture of stack traces, see EnableStackTrace.
+	VerbosityFull
+)
+
+var verbosityNames = [...]string{
+	VerbosityOff:      "off",
+	VerbosityBrief:    "brief",
+	VerbosityDetailed: "detailed",
+	VerbosityFull:     "full",
+}
+
+// String returns the name of the verbosity.
+func (v Verbosity) String() string {
+	if v < 0 || int(v) >= len(verbosityNames) {
+		return "Verbosity(" + fmt.Sprint(int32(v)) + ")"
+	}
+
+	return verbosityNames[v]
+}
+
+// ParseVerbosity returns the Verbosity of its case-insensitive name.
+func ParseVerbosity(name string) (Verbosity, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for v, n := range verbosityNames {
+		if n == name {
+			return Verbosity(v), nil
+		}
+	}
+
+	return 0, Enrich(ErrInvalidVerbosity, "verbosity", name)
+}
+
+var verbosity atomic.Int32
+
+func init() {
+	verbosity.Store(int32(VerbosityDetailed))
+
+	if v, err := ParseVerbosity(os.Getenv(VerbosityEnv)); err == nil {
+		verbosity.Store(int32(v))
+	}
+}
+
+// SetVerbosity sets the Verbosity of Render, overriding the one read from VerbosityEnv at init.
+func SetVerbosity(v Verbosity) {
+	verbosity.Store(int32(v))
+}
+
+// CurrentVerbosity returns the Verbosity of Render.
+func CurrentVerbosity() Verbosity {
+	return Verbosity(verbosity.Load())
+}
+
+// Render returns err rendered with the current Verbosity.
+func Render(err error) string {
+	return RenderVerbosity(err, CurrentVerbosity())
+}
+
+// RenderVerbosity returns err rendered with the Verbosity v.
+func RenderVerbosity(err error, v Verbosity) string {
+	switch v {
+	case VerbosityOff:
+		return ""
+	case VerbosityBrief:
+		return Brief(err)
+	case VerbosityFull:
+		return Full(err)
+	default:
+		return Detailed(err)
+	}
+}
+
+// Brief returns the message of the outermost error of the chain of err, without the messages
+// of the errors it wraps, e.g. "get user" for "get user: no rows".
+func Brief(err error) string {
+	if IsNil(err) {
+		return ""
+	}
+
+	msg := err.Error()
+
+	for {
+		next := Cause(err)
+		if next == nil {
+			next = Unwrap(err)
+		}
+
+		if next == nil {
+			return msg
+		}
+
+		// Wrappers that do not change the message, e.g. enrichedError, are skipped.
+		if nextMsg := next.Error(); nextMsg != msg {
+			return strings.TrimSuffix(msg, ": "+nextMsg)
+		}
+
+		err = next
+	}
+}
+
+// Detailed returns the message of err with the messages of its chain, the same as err.Error().
+func Detailed(err error) string {
+	if IsNil(err) {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// Full returns the chain of err, one error per line with its stack trace when captured,
+// as printed by %+v, followed by the key-value pairs of the chain, see Tuples.
+func Full(err error) string {
+	if IsNil(err) {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	writeVerbose(&sb, err)
+
+	if kv := Tuples(err); len(kv) > 0 {
+		sb.WriteString("\nfields:")
+
+		for i := 0; i < len(kv); i += 2 {
+			sb.WriteString(" ")
+			sb.WriteString(fmt.Sprint(kv[i]))
+			sb.WriteString("=")
+
+			if i+1 < len(kv) {
+				sb.WriteString(fmt.Sprint(kv[i+1]))
+			}
+		}
+	}
+
+	return sb.String()
+}
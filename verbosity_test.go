@@ -0,0 +1,103 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestBrief(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+
+	testCases := []struct {
+		scenario string
+		err      error
+		expected string
+	}{
+		{scenario: "nil"},
+		{scenario: "new", err: errNotFound, expected: "not found"},
+		{scenario: "wrap", err: errors.Wrap(errNotFound, "get user"), expected: "get user"},
+		{scenario: "wrap error", err: errors.WrapError(errors.New("no rows"), errNotFound), expected: "not found"},
+		{
+			scenario: "enriched",
+			err:      errors.Enrich(errors.Wrap(errNotFound, "get user"), "id", 5),
+			expected: "get user",
+		},
+		{scenario: "foreign", err: fmt.Errorf("get user: %w", errNotFound), expected: "get user"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.scenario, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, errors.Brief(tc.err))
+		})
+	}
+}
+
+func TestDetailed(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, errors.Detailed(nil))
+	assert.Equal(t, "get user: not found", errors.Detailed(errors.Wrap(errors.New("not found"), "get user")))
+}
+
+func TestFull(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, errors.Full(nil))
+
+	err := errors.Enrich(errors.Wrap(errors.New("not found"), "get user"), "id", 5, "op", "get")
+
+	assert.Equal(t, fmt.Sprintf("%+v", err)+"\nfields: id=5 op=get", errors.Full(err))
+}
+
+func TestParseVerbosity(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []errors.Verbosity{
+		errors.VerbosityOff, errors.VerbosityBrief, errors.VerbosityDetailed, errors.VerbosityFull,
+	} {
+		p, err := errors.ParseVerbosity(" " + strings.ToUpper(v.String()) + " ")
+		require.NoError(t, err)
+		assert.Equal(t, v, p)
+	}
+
+	_, err := errors.ParseVerbosity("loud")
+	require.ErrorIs(t, err, errors.ErrInvalidVerbosity)
+	require.NotErrorIs(t, errors.New("invalid verbosity"), errors.ErrInvalidVerbosity, "only the sentinel matches")
+	assert.Equal(t, "Verbosity(9)", errors.Verbosity(9).String())
+}
+
+//nolint:paralleltest // Changes the verbosity.
+func TestRender(t *testing.T) {
+	defer errors.SetVerbosity(errors.CurrentVerbosity())
+
+	err := errors.Enrich(errors.Wrap(errors.New("not found"), "get user"), "id", 5)
+
+	errors.SetVerbosity(errors.VerbosityOff)
+	assert.Empty(t, errors.Render(err))
+
+	errors.SetVerbosity(errors.VerbosityBrief)
+	assert.Equal(t, "get user", errors.Render(err))
+
+	errors.SetVerbosity(errors.VerbosityDetailed)
+	assert.Equal(t, "get user: not found", errors.Render(err))
+
+	errors.SetVerbosity(errors.VerbosityFull)
+
+	err = errors.Wrap(errors.New("not found"), "get user")
+
+	require.NotNil(t, errors.StackTrace(err), "full verbosity captures stack traces")
+	assert.Equal(t, errors.Full(err), errors.Render(err))
+	assert.Contains(t, errors.Render(err), "TestRender")
+}
@@ -0,0 +1,69 @@
+package errors
+
+import "fmt"
+
+// FieldViolation describes a single invalid field of a request.
+type FieldViolation struct {
+	// Field is the path of the field, e.g. "user.email".
+	Field string `json:"field"`
+	// Description explains why the field is invalid.
+	Description string `json:"description"`
+}
+
+type violationsError struct {
+	err        error
+	violations []FieldViolation
+}
+
+// Error implements the standard library error interface.
+func (ve *violationsError) Error() string {
+	return ve.err.Error()
+}
+
+// Unwrap implements errors.Unwrap for Error.
+func (ve *violationsError) Unwrap() error {
+	return ve.err
+}
+
+// Format implements fmt.Formatter, %+v prints the error chain with stack traces.
+func (ve *violationsError) Format(st fmt.State, verb rune) {
+	formatError(ve, st, verb)
+}
+
+// FieldViolations returns err carrying the invalid fields of a request, see Violations.
+//
+// The violations are sent to clients by ToStatus as an errdetails.BadRequest detail, and recreated by FromStatus.
+// Violations added by nested calls accumulate, the outermost ones first.
+// If err is nil, FieldViolations returns nil.
+func FieldViolations(err error, violations ...FieldViolation) error {
+	if IsNil(err) {
+		return nil
+	}
+
+	return &violationsError{err: err, violations: append([]FieldViolation(nil), violations...)}
+}
+
+// Violations returns the field violations of the chain of err: added by FieldViolations or received
+// in the errdetails.BadRequest detail of a grpc status. It returns nil if there are none.
+func Violations(err error) []FieldViolation {
+	if IsNil(err) {
+		return nil
+	}
+
+	var violations []FieldViolation
+
+	walk(err, func(err error) bool {
+		//nolint:errorlint
+		if ve, ok := err.(*violationsError); ok {
+			violations = append(violations, ve.violations...)
+		}
+
+		return true
+	})
+
+	if violations != nil {
+		return violations
+	}
+
+	return statusViolations(err)
+}
@@ -0,0 +1,76 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestFieldViolations(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := errors.New("invalid user")
+	email := errors.FieldViolation{Field: "email", Description: "must be an email address"}
+	name := errors.FieldViolation{Field: "name", Description: "must not be empty"}
+
+	err := errors.WithCode(errors.FieldViolations(errors.FieldViolations(errInvalid, name), email), codes.InvalidArgument)
+
+	t.Run("local", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, errors.FieldViolations(nil, email))
+		assert.Nil(t, errors.Violations(errInvalid))
+		assert.Equal(t, []errors.FieldViolation{email, name}, errors.Violations(err))
+		assert.Equal(t, []errors.FieldViolation{email, name}, errors.Violations(errors.Clone(err)))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		t.Parallel()
+
+		st := toStatus(t, err, codes.InvalidArgument)
+
+		var br *errdetails.BadRequest
+
+		for _, d := range st.Details() {
+			if v, ok := d.(*errdetails.BadRequest); ok {
+				br = v
+			}
+		}
+
+		require.NotNil(t, br)
+		require.Len(t, br.GetFieldViolations(), 2)
+		assert.Equal(t, "email", br.GetFieldViolations()[0].GetField())
+
+		dErr := fromStatus(t, st)
+		require.ErrorIs(t, dErr, errInvalid)
+		assert.Equal(t, []errors.FieldViolation{email, name}, errors.Violations(dErr))
+
+		// Statuses built by other servers are read through their details.
+		st, sErr := status.New(codes.InvalidArgument, "invalid").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "name", Description: "must not be empty"}},
+		})
+		require.NoError(t, sErr)
+		assert.Equal(t, []errors.FieldViolation{name}, errors.Violations(st.Err()))
+	})
+
+	for _, codec := range codecs {
+		t.Run("codec "+codec, func(t *testing.T) {
+			t.Parallel()
+
+			b, eErr := errors.Encode(codec, err)
+			require.NoError(t, eErr)
+
+			env, eErr := errors.Decode(codec, b)
+			require.NoError(t, eErr)
+
+			dErr := env.Err()
+			assert.Equal(t, []errors.FieldViolation{email, name}, errors.Violations(dErr))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package errors
+
+// Walk calls fn for err and every error of its chain, depth first, until fn returns false.
+//
+// Both branches of the errors created by WrapError are visited, the supplied error before the cause,
+// as well as every error of the errors created by Join. Errors of other packages are followed
+// through their Unwrap and Cause methods.
+func Walk(err error, fn func(err error) bool) {
+	if IsNil(err) {
+		return
+	}
+
+	walk(err, fn)
+}
+
+// walk calls fn for err and every error of its chain, visiting the joined errors,
+// the wrapped error and then the cause, until fn returns false.
+func walk(err error, fn func(err error) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	if !fn(err) {
+		return false
+	}
+
+	for _, mErr := range unwrapMulti(err) {
+		if !walk(mErr, fn) {
+			return false
+		}
+	}
+
+	if !walk(Unwrap(err), fn) {
+		return false
+	}
+
+	return walk(Cause(err), fn)
+}
+
+// Chain returns the errors of the chain of err in the order Walk visits them, from the outermost
+// error to the root cause, nil if err is nil.
+func Chain(err error) []error {
+	var chain []error
+
+	Walk(err, func(err error) bool {
+		chain = append(chain, err)
+
+		return true
+	})
+
+	return chain
+}
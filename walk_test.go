@@ -0,0 +1,74 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dohernandez/errors"
+)
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	err := errors.Join(
+		fmt.Errorf("get: %w", errors.WrapError(errors.New("no rows"), errNotFound)),
+		errors.Wrap(errors.New("timeout"), "list"),
+	)
+
+	var messages []string
+
+	errors.Walk(err, func(err error) bool {
+		messages = append(messages, err.Error())
+
+		return true
+	})
+
+	assert.Equal(t, []string{
+		"get: not found: no rows\nlist: timeout",
+		"get: not found: no rows",
+		"not found: no rows",
+		"not found",
+		"no rows",
+		"list: timeout",
+		"timeout",
+	}, messages)
+
+	t.Run("stop", func(t *testing.T) {
+		t.Parallel()
+
+		var visited int
+
+		errors.Walk(err, func(err error) bool {
+			visited++
+
+			return err.Error() != "not found"
+		})
+
+		assert.Equal(t, 4, visited)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		errors.Walk(nil, func(error) bool {
+			t.Fail()
+
+			return true
+		})
+	})
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, errors.Chain(nil))
+
+	root := errors.New("failed")
+	wrapped := errors.Wrap(root, "read")
+	err := errors.Wrap(wrapped, "oops")
+
+	assert.Equal(t, []error{err, wrapped, root}, errors.Chain(err))
+}